@@ -0,0 +1,198 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the action satisfies the required framework interfaces.
+var (
+	_ action.Action                   = (*renewDomainAction)(nil)
+	_ action.ActionWithConfigure      = (*renewDomainAction)(nil)
+	_ action.ActionWithValidateConfig = (*renewDomainAction)(nil)
+)
+
+// renewDomainAction renews a domain for a number of years, refusing to pay
+// more than a configured price.
+type renewDomainAction struct {
+	client *namecom.NameCom
+}
+
+// renewDomainModel maps the renew domain schema to a Go struct.
+type renewDomainModel struct {
+	DomainName types.String  `tfsdk:"domain_name"`
+	Years      types.Int32   `tfsdk:"years"`
+	MaxPrice   types.Float64 `tfsdk:"max_price"`
+}
+
+// NewRenewDomainAction is the action factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the action.Action interface.
+func NewRenewDomainAction() action.Action {
+	return &renewDomainAction{}
+}
+
+func (a *renewDomainAction) Metadata(_ context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_renew_domain"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (a *renewDomainAction) Schema(_ context.Context, _ action.SchemaRequest, resp *action.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Renews a domain with Name.com. The order ID and new expiry date are reported as progress messages.",
+		Attributes: map[string]schema.Attribute{
+			keyDomainName: schema.StringAttribute{
+				Required:    true,
+				Description: "DomainName is the domain to renew.",
+			},
+			keyYears: schema.Int32Attribute{
+				Optional:    true,
+				Validators:  []validator.Int32{int32validator.Between(registrationYearsMin, registrationYearsMax)},
+				Description: "Years is how long to renew the domain for, 1-10 years. Defaults to 1.",
+			},
+			keyMaxPrice: schema.Float64Attribute{
+				Required:    true,
+				Description: "MaxPrice is the most, in USD, the renewal may cost for all years together. The price is the domain's renewal price times years; a higher price fails instead of renewing.",
+			},
+		},
+	}
+}
+
+func (a *renewDomainAction) Configure(_ context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	a.client = client
+}
+
+// ValidateConfig rejects a negative price guard, which could never be met.
+func (a *renewDomainAction) ValidateConfig(ctx context.Context, req action.ValidateConfigRequest, resp *action.ValidateConfigResponse) {
+	var maxPrice types.Float64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyMaxPrice), &maxPrice)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !maxPrice.IsNull() && !maxPrice.IsUnknown() && maxPrice.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyMaxPrice),
+			"Invalid max_price",
+			fmt.Sprintf("max_price must not be negative, got %g.", maxPrice.ValueFloat64()),
+		)
+	}
+}
+
+// Invoke prices the renewal from the domain's renewal price, checks it against
+// max_price and renews the domain at that price, so Name.com refuses the order
+// if the price changes in between.
+func (a *renewDomainAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	var config renewDomainModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := config.DomainName.ValueString()
+
+	years := int32(registrationYearsMin)
+	if !config.Years.IsNull() {
+		years = config.Years.ValueInt32()
+	}
+
+	domain, found, err := readDomainAPI(ctx, a.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading domain", err.Error())
+
+		return
+	}
+
+	if !found {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyDomainName),
+			"Domain not found",
+			fmt.Sprintf("%s is not registered in this Name.com account.", domainName),
+		)
+
+		return
+	}
+
+	if domain.RenewalPrice == 0 {
+		resp.Diagnostics.AddError(
+			"Renewal price unknown",
+			fmt.Sprintf("Name.com does not report a renewal price for %s, so the renewal cannot be checked against max_price.", domainName),
+		)
+
+		return
+	}
+
+	price := totalPrice(domain.RenewalPrice, years)
+
+	if price > config.MaxPrice.ValueFloat64() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyMaxPrice),
+			"Renewal price exceeds max_price",
+			fmt.Sprintf("Renewing %s for %d year(s) costs %.2f USD, more than max_price of %.2f USD.",
+				domainName, years, price, config.MaxPrice.ValueFloat64()),
+		)
+
+		return
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Renewing %s for %d year(s) at %.2f USD.", domainName, years, price),
+	})
+
+	renewed, err := renewDomainAPI(ctx, a.client, domainName, price, years)
+	if err != nil {
+		resp.Diagnostics.AddError("Error renewing domain", err.Error())
+
+		return
+	}
+
+	expireDate := ""
+	if renewed.Domain != nil {
+		expireDate = renewed.Domain.ExpireDate
+	}
+
+	resp.SendProgress(action.InvokeProgressEvent{
+		Message: fmt.Sprintf("Renewed %s: order %d, %.2f USD paid, now expires %s.", domainName, renewed.Order, renewed.TotalPaid, expireDate),
+	})
+}
+
+// renewDomainAPI renews a domain via the Name.com API.
+func renewDomainAPI(
+	ctx context.Context,
+	client *namecom.NameCom,
+	domainName string,
+	price float64,
+	years int32,
+) (*namecom.RenewDomainResponse, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "rate limiting error")
+	}
+
+	resp, err := client.RenewDomain(&namecom.RenewDomainRequest{
+		DomainName:    domainName,
+		PurchasePrice: price,
+		Years:         years,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error RenewDomain")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,63 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// TestDomainAuthCodeOpen confirms the auth code is fetched for the configured
+// domain and returned as a sensitive result.
+//
+//nolint:paralleltest // Exercises the global rate limiter.
+func TestDomainAuthCodeOpen(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com:getAuthCode", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"authCode":"Xy7-secret"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	res := &domainAuthCodeEphemeralResource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp ephemeral.SchemaResponse
+
+	res.Schema(context.Background(), ephemeral.SchemaRequest{}, &schemaResp)
+
+	if !schemaResp.Schema.Attributes[keyAuthCode].IsSensitive() {
+		t.Error("auth_code should be sensitive")
+	}
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	config.Set(context.Background(), &domainAuthCodeModel{
+		DomainName: types.StringValue("example.com"),
+		AuthCode:   types.StringNull(),
+	})
+
+	resp := ephemeral.OpenResponse{Result: tfsdk.EphemeralResultData{Schema: schemaResp.Schema, Raw: config.Raw}}
+
+	res.Open(context.Background(), ephemeral.OpenRequest{Config: tfsdk.Config(config)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got domainAuthCodeModel
+
+	resp.Result.Get(context.Background(), &got)
+
+	if got.AuthCode.ValueString() != "Xy7-secret" {
+		t.Errorf("auth_code = %v, want the code from the API", got.AuthCode)
+	}
+}
@@ -0,0 +1,121 @@
+//nolint:paralleltest // The autorenew tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// autorenewServer reports example.com with autorenew off and counts the calls
+// that disable it.
+func autorenewServer(t *testing.T, disables *int) *namecom.NameCom {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domainName":"example.com","autorenewEnabled":false}`)
+	})
+	mux.HandleFunc("/v4/domains/example.com:disableAutorenew", func(writer http.ResponseWriter, _ *http.Request) {
+		*disables++
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domainName":"example.com"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return namecom.Mock("u", "t", server.URL)
+}
+
+// autorenewState builds a tfsdk.State carrying the autorenew schema and model.
+func autorenewState(t *testing.T, model domainAutorenewModel) tfsdk.State {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+
+	(&domainAutorenewResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+
+	diags := state.Set(context.Background(), &model)
+	if diags.HasError() {
+		t.Fatalf("building autorenew state: %v", diags)
+	}
+
+	return state
+}
+
+func autorenewModel(enabled bool, onDestroy string) domainAutorenewModel {
+	return domainAutorenewModel{
+		ID:         types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Enabled:    types.BoolValue(enabled),
+		OnDestroy:  types.StringValue(onDestroy),
+	}
+}
+
+// TestDomainAutorenewRead_Drift confirms autorenew turned off outside
+// Terraform is reported, so the next apply turns it back on.
+func TestDomainAutorenewRead_Drift(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	var disables int
+
+	res := &domainAutorenewResource{client: autorenewServer(t, &disables)}
+	state := autorenewState(t, autorenewModel(true, onDestroyKeep))
+	resp := resource.ReadResponse{State: state}
+
+	res.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got domainAutorenewModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.Enabled.ValueBool() {
+		t.Error("enabled = true, want the drifted false")
+	}
+}
+
+// TestDomainAutorenewDelete_OnDestroy confirms destroy leaves autorenew alone
+// by default and only disables it when on_destroy asks for it.
+func TestDomainAutorenewDelete_OnDestroy(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	for _, testCase := range []struct {
+		onDestroy string
+		want      int
+	}{
+		{onDestroyKeep, 0},
+		{onDestroyDisable, 1},
+	} {
+		var disables int
+
+		res := &domainAutorenewResource{client: autorenewServer(t, &disables)}
+		state := autorenewState(t, autorenewModel(true, testCase.onDestroy))
+		resp := resource.DeleteResponse{State: state}
+
+		res.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", testCase.onDestroy, resp.Diagnostics)
+		}
+
+		if disables != testCase.want {
+			t.Errorf("%s: DisableAutorenew calls = %d, want %d", testCase.onDestroy, disables, testCase.want)
+		}
+	}
+}
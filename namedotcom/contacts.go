@@ -0,0 +1,326 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Contact roles a domain carries, as named by the Name.com API.
+const (
+	keyRegistrant = "registrant"
+	keyAdmin      = "admin"
+	keyTech       = "tech"
+	keyBilling    = "billing"
+)
+
+// contactModel maps one contact of a domain to a Go struct.
+type contactModel struct {
+	FirstName   types.String `tfsdk:"first_name"`
+	LastName    types.String `tfsdk:"last_name"`
+	CompanyName types.String `tfsdk:"company_name"`
+	Address1    types.String `tfsdk:"address1"`
+	Address2    types.String `tfsdk:"address2"`
+	City        types.String `tfsdk:"city"`
+	State       types.String `tfsdk:"state"`
+	Zip         types.String `tfsdk:"zip"`
+	Country     types.String `tfsdk:"country"`
+	Phone       types.String `tfsdk:"phone"`
+	Fax         types.String `tfsdk:"fax"`
+	Email       types.String `tfsdk:"email"`
+}
+
+// contactsModel maps the per-role contacts of a domain. A role left out takes
+// the registrant, or the account default contact when there is none.
+type contactsModel struct {
+	Registrant *contactModel `tfsdk:"registrant"`
+	Admin      *contactModel `tfsdk:"admin"`
+	Tech       *contactModel `tfsdk:"tech"`
+	Billing    *contactModel `tfsdk:"billing"`
+}
+
+// contactAttributes is the schema of a single contact.
+//
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func contactAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"first_name":   schema.StringAttribute{Required: true, Description: "First name of the contact."},
+		"last_name":    schema.StringAttribute{Required: true, Description: "Last name of the contact."},
+		"company_name": schema.StringAttribute{Optional: true, Description: "Company name of the contact. Leave unset for an individual, as some registries assume a corporate entity otherwise."},
+		"address1":     schema.StringAttribute{Required: true, Description: "First line of the contact's address."},
+		"address2":     schema.StringAttribute{Optional: true, Description: "Second line of the contact's address."},
+		"city":         schema.StringAttribute{Required: true, Description: "City of the contact's address."},
+		"state":        schema.StringAttribute{Optional: true, Description: "State or province of the contact's address."},
+		"zip":          schema.StringAttribute{Optional: true, Description: "Zip or postal code of the contact's address."},
+		"country":      schema.StringAttribute{Required: true, Validators: []validator.String{countryCodeValidator()}, Description: "ISO 3166-1 alpha-2 country code of the contact's address, such as US."},
+		"phone":        schema.StringAttribute{Required: true, Validators: []validator.String{phoneValidator()}, Description: `Phone number of the contact: an E.164 number with a dot after the country calling code, such as "+1.4155550100".`},
+		"fax":          schema.StringAttribute{Optional: true, Validators: []validator.String{phoneValidator()}, Description: `Fax number of the contact, in the same format as phone.`},
+		"email":        schema.StringAttribute{Required: true, Validators: []validator.String{emailValidator()}, Description: "Email address of the contact."},
+	}
+}
+
+// contactsAttributes is the schema of the per-role contacts of a domain.
+func contactsAttributes() map[string]schema.Attribute {
+	role := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Optional:    true,
+			Attributes:  contactAttributes(),
+			Description: description,
+		}
+	}
+
+	return map[string]schema.Attribute{
+		keyRegistrant: role("Registrant is the legal owner of the domain."),
+		keyAdmin:      role("Admin is the administrative contact of the domain. Defaults to the registrant."),
+		keyTech:       role("Tech is the technical contact of the domain. Defaults to the registrant."),
+		keyBilling:    role("Billing is the billing contact of the domain. Defaults to the registrant."),
+	}
+}
+
+// expandContacts converts a contacts object into the API form. A null or
+// unknown object yields nil, so the account default contacts apply. A role
+// left out takes the registrant, as in namedotcom_domain_contacts, unless the
+// registrant is left out too.
+func expandContacts(ctx context.Context, contacts types.Object) (*namecom.Contacts, diag.Diagnostics) {
+	if contacts.IsNull() || contacts.IsUnknown() {
+		return nil, nil
+	}
+
+	var model contactsModel
+
+	diags := contacts.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	registrant := expandContact(model.Registrant)
+
+	role := func(contact *contactModel) *namecom.Contact {
+		if contact == nil {
+			return registrant
+		}
+
+		return expandContact(contact)
+	}
+
+	return &namecom.Contacts{
+		Registrant: registrant,
+		Admin:      role(model.Admin),
+		Tech:       role(model.Tech),
+		Billing:    role(model.Billing),
+	}, diags
+}
+
+// expandContact converts one contact into the API form; nil stays nil.
+func expandContact(contact *contactModel) *namecom.Contact {
+	if contact == nil {
+		return nil
+	}
+
+	return &namecom.Contact{
+		FirstName:   contact.FirstName.ValueString(),
+		LastName:    contact.LastName.ValueString(),
+		CompanyName: contact.CompanyName.ValueString(),
+		Address1:    contact.Address1.ValueString(),
+		Address2:    contact.Address2.ValueString(),
+		City:        contact.City.ValueString(),
+		State:       contact.State.ValueString(),
+		Zip:         contact.Zip.ValueString(),
+		Country:     contact.Country.ValueString(),
+		Phone:       contact.Phone.ValueString(),
+		Fax:         contact.Fax.ValueString(),
+		Email:       contact.Email.ValueString(),
+	}
+}
+
+// flattenContact converts a contact read from the API into the model. A field
+// that differs from prior only cosmetically (letter case, spacing, or the
+// punctuation of a phone number) keeps its prior value, so formatting applied
+// by Name.com or the registry does not show up as drift. A nil prior is
+// treated as having no values.
+func flattenContact(prior *contactModel, contact *namecom.Contact) *contactModel {
+	if contact == nil {
+		return nil
+	}
+
+	if prior == nil {
+		prior = &contactModel{}
+	}
+
+	return &contactModel{
+		FirstName:   reconcileContactField(prior.FirstName, contact.FirstName, sameText),
+		LastName:    reconcileContactField(prior.LastName, contact.LastName, sameText),
+		CompanyName: reconcileContactField(prior.CompanyName, contact.CompanyName, sameText),
+		Address1:    reconcileContactField(prior.Address1, contact.Address1, sameText),
+		Address2:    reconcileContactField(prior.Address2, contact.Address2, sameText),
+		City:        reconcileContactField(prior.City, contact.City, sameText),
+		State:       reconcileContactField(prior.State, contact.State, sameText),
+		Zip:         reconcileContactField(prior.Zip, contact.Zip, sameText),
+		Country:     reconcileContactField(prior.Country, contact.Country, sameText),
+		Phone:       reconcileContactField(prior.Phone, contact.Phone, samePhone),
+		Fax:         reconcileContactField(prior.Fax, contact.Fax, samePhone),
+		Email:       reconcileContactField(prior.Email, contact.Email, sameText),
+	}
+}
+
+// sameContact reports whether two API contacts differ only cosmetically.
+func sameContact(a, b *namecom.Contact) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return sameText(a.FirstName, b.FirstName) && sameText(a.LastName, b.LastName) &&
+		sameText(a.CompanyName, b.CompanyName) && sameText(a.Address1, b.Address1) &&
+		sameText(a.Address2, b.Address2) && sameText(a.City, b.City) &&
+		sameText(a.State, b.State) && sameText(a.Zip, b.Zip) &&
+		sameText(a.Country, b.Country) && samePhone(a.Phone, b.Phone) &&
+		samePhone(a.Fax, b.Fax) && sameText(a.Email, b.Email)
+}
+
+// reconcileContactField keeps prior when it matches the API value under same,
+// and otherwise takes the API value, with an empty value read as null.
+func reconcileContactField(prior types.String, value string, same func(a, b string) bool) types.String {
+	if !prior.IsUnknown() && same(prior.ValueString(), value) {
+		return prior
+	}
+
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// sameText compares ignoring letter case and runs of whitespace.
+func sameText(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), " "), strings.Join(strings.Fields(b), " "))
+}
+
+// samePhone compares only the digits of two phone numbers.
+func samePhone(a, b string) bool {
+	digits := func(phone string) string {
+		return strings.Map(func(char rune) rune {
+			if char >= '0' && char <= '9' {
+				return char
+			}
+
+			return -1
+		}, phone)
+	}
+
+	return digits(a) == digits(b)
+}
+
+// Limits of E.164 phone numbers.
+const (
+	// e164MaxDigits is the most digits a number has, country code included.
+	e164MaxDigits = 15
+	// e164CountryCodeMaxDigits is the most digits a country calling code has.
+	e164CountryCodeMaxDigits = 3
+)
+
+// iso3166Alpha2 lists the assigned ISO 3166-1 alpha-2 country codes.
+var iso3166Alpha2 = strings.Fields(
+	"AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE " +
+		"BF BG BH BI BJ BL BM BN BO BQ BR BS BT BV BW BY BZ CA CC CD " +
+		"CF CG CH CI CK CL CM CN CO CR CU CV CW CX CY CZ DE DJ DK DM " +
+		"DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF " +
+		"GG GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU " +
+		"ID IE IL IM IN IO IQ IR IS IT JE JM JO JP KE KG KH KI KM KN " +
+		"KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV LY MA MC MD ME " +
+		"MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA " +
+		"NC NE NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM " +
+		"PN PR PS PT PW PY QA RE RO RS RU RW SA SB SC SD SE SG SH SI " +
+		"SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF TG TH TJ TK " +
+		"TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI " +
+		"VN VU WF WS YE YT ZA ZM ZW",
+)
+
+// checkPhone validates a phone number in the form Name.com stores: an E.164
+// number with a dot between the country calling code and the rest.
+func checkPhone(phone string) error {
+	countryCode, number, found := strings.Cut(strings.TrimPrefix(phone, "+"), ".")
+	if !strings.HasPrefix(phone, "+") || !found {
+		return errors.New(`expected "+", the country calling code, a dot and the number, such as "+1.4155550100"`)
+	}
+
+	if !isDigits(countryCode) || len(countryCode) > e164CountryCodeMaxDigits || countryCode[0] == '0' {
+		return errors.Newf("country calling code %q must be 1-3 digits, not starting with 0", countryCode)
+	}
+
+	if !isDigits(number) {
+		return errors.Newf("number %q after the country calling code must be digits only", number)
+	}
+
+	if len(countryCode)+len(number) > e164MaxDigits {
+		return errors.Newf("E.164 numbers have at most %d digits, got %d", e164MaxDigits, len(countryCode)+len(number))
+	}
+
+	return nil
+}
+
+// checkCountryCode validates an ISO 3166-1 alpha-2 code, in either case.
+func checkCountryCode(code string) error {
+	if !slices.Contains(iso3166Alpha2, strings.ToUpper(code)) {
+		return errors.Newf("%q is not an ISO 3166-1 alpha-2 country code", code)
+	}
+
+	return nil
+}
+
+// checkEmail validates a bare email address, without a display name.
+func checkEmail(email string) error {
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email {
+		return errors.Newf("%q is not a valid email address", email)
+	}
+
+	return nil
+}
+
+func phoneValidator() validator.String {
+	return contactFieldValidator{description: "phone number", check: checkPhone}
+}
+
+func countryCodeValidator() validator.String {
+	return contactFieldValidator{description: "country code", check: checkCountryCode}
+}
+
+func emailValidator() validator.String {
+	return contactFieldValidator{description: "email address", check: checkEmail}
+}
+
+// contactFieldValidator adapts a check function to validator.String.
+type contactFieldValidator struct {
+	description string
+	check       func(string) error
+}
+
+func (v contactFieldValidator) Description(_ context.Context) string {
+	return v.description
+}
+
+func (v contactFieldValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v contactFieldValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	err := v.check(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid "+v.description, fmt.Sprintf("%s.", err))
+	}
+}
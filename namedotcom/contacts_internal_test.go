@@ -0,0 +1,198 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// TestContactFieldChecks pins the phone, country code and email rules.
+func TestContactFieldChecks(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		check   func(string) error
+		value   string
+		wantErr bool
+	}{
+		{"phone", checkPhone, "+1.4155550100", false},
+		{"phone with a 3-digit country code", checkPhone, "+420.123456789", false},
+		{"phone without the dot", checkPhone, "+14155550100", true},
+		{"phone without the plus", checkPhone, "1.4155550100", true},
+		{"phone with a leading-zero country code", checkPhone, "+01.4155550100", true},
+		{"phone with a 4-digit country code", checkPhone, "+1234.5550100", true},
+		{"phone with separators", checkPhone, "+1.415-555-0100", true},
+		{"phone too long", checkPhone, "+44.12345678901234", true},
+		{"phone with an empty country code", checkPhone, "+.4155550100", true},
+		{"country", checkCountryCode, "US", false},
+		{"country in lower case", checkCountryCode, "de", false},
+		{"unassigned country", checkCountryCode, "XX", true},
+		{"alpha-3 country", checkCountryCode, "USA", true},
+		{"email", checkEmail, "hostmaster@example.com", false},
+		{"email with a display name", checkEmail, "Host <hostmaster@example.com>", true},
+		{"email without a domain", checkEmail, "hostmaster", true},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := testCase.check(testCase.value); (err != nil) != testCase.wantErr {
+				t.Errorf("check(%q) = %v, wantErr %v", testCase.value, err, testCase.wantErr)
+			}
+		})
+	}
+}
+
+// TestFlattenContact_Cosmetic confirms formatting applied by the API keeps
+// TestExpandContacts_RolesDefaultToRegistrant confirms the registration
+// contacts of namedotcom_domain fill a role left out with the registrant, as
+// namedotcom_domain_contacts does, and leave every role to the account
+// defaults when the registrant is left out too.
+func TestExpandContacts_RolesDefaultToRegistrant(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrTypes := domainSchemaAttrTypes(t, keyContacts)
+
+	registrant := &contactModel{FirstName: types.StringValue("Jane"), Email: types.StringValue("jane@example.com")}
+	tech := &contactModel{FirstName: types.StringValue("Ops"), Email: types.StringValue("ops@example.com")}
+
+	object, diags := types.ObjectValueFrom(ctx, attrTypes, contactsModel{Registrant: registrant, Tech: tech})
+	if diags.HasError() {
+		t.Fatalf("building contacts: %v", diags)
+	}
+
+	got, diags := expandContacts(ctx, object)
+	if diags.HasError() {
+		t.Fatalf("expandContacts: %v", diags)
+	}
+
+	if got.Admin.FirstName != "Jane" || got.Billing.FirstName != "Jane" || got.Tech.FirstName != "Ops" {
+		t.Errorf("admin, billing, tech = %q, %q, %q; want Jane, Jane, Ops", got.Admin.FirstName, got.Billing.FirstName, got.Tech.FirstName)
+	}
+
+	object, diags = types.ObjectValueFrom(ctx, attrTypes, contactsModel{Tech: tech})
+	if diags.HasError() {
+		t.Fatalf("building contacts: %v", diags)
+	}
+
+	got, _ = expandContacts(ctx, object)
+
+	if got.Registrant != nil || got.Admin != nil || got.Billing != nil {
+		t.Errorf("contacts = %+v, want only tech set without a registrant", got)
+	}
+}
+
+// the configured values, while a real change is taken from the API.
+func TestFlattenContact_Cosmetic(t *testing.T) {
+	t.Parallel()
+
+	prior := &contactModel{
+		FirstName: types.StringValue("Jane"),
+		City:      types.StringValue("San Francisco"),
+		Country:   types.StringValue("us"),
+		Phone:     types.StringValue("+1.4155550100"),
+		Email:     types.StringValue("Jane@Example.com"),
+	}
+
+	got := flattenContact(prior, &namecom.Contact{
+		FirstName: "JANE",
+		LastName:  "Doe",
+		City:      "San  Francisco ",
+		Country:   "US",
+		Phone:     "+1 415 555 0100",
+		Email:     "jane@example.com",
+	})
+
+	for name, pair := range map[string][2]types.String{
+		"first_name": {got.FirstName, prior.FirstName},
+		"city":       {got.City, prior.City},
+		"country":    {got.Country, prior.Country},
+		"phone":      {got.Phone, prior.Phone},
+		"email":      {got.Email, prior.Email},
+	} {
+		if !pair[0].Equal(pair[1]) {
+			t.Errorf("%s = %v, want the configured %v", name, pair[0], pair[1])
+		}
+	}
+
+	if got.LastName.ValueString() != "Doe" {
+		t.Errorf("last_name = %v, want the changed value from the API", got.LastName)
+	}
+
+	if !got.Address2.IsNull() {
+		t.Errorf("address2 = %v, want null for an empty API value", got.Address2)
+	}
+}
+
+// TestDomainContactsRead_Roles confirms a role left out stays out while the
+// API reports it equal to the registrant, and shows up once it differs.
+//
+//nolint:paralleltest // Exercises the global rate limiter.
+func TestDomainContactsRead_Roles(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	const contact = `{"firstName":"Jane","lastName":"Doe","address1":"1 Main St","city":"Denver",` +
+		`"country":"US","phone":"+1.3035550100","email":"jane@example.com"}`
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"domainName":"example.com","contacts":{"registrant":%s,"admin":%s,"billing":%s,`+
+			`"tech":{"firstName":"Ops","lastName":"Team","address1":"1 Main St","city":"Denver",`+
+			`"country":"US","phone":"+1.3035550199","email":"ops@example.com"}}}`, contact, contact, contact)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	res := &domainContactsResource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp resource.SchemaResponse
+
+	res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(context.Background(), &domainContactsModel{
+		ID:         types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Registrant: &contactModel{
+			FirstName: types.StringValue("Jane"),
+			LastName:  types.StringValue("Doe"),
+			Address1:  types.StringValue("1 Main St"),
+			City:      types.StringValue("Denver"),
+			Country:   types.StringValue("US"),
+			Phone:     types.StringValue("+1.3035550100"),
+			Email:     types.StringValue("jane@example.com"),
+		},
+	})
+
+	resp := resource.ReadResponse{State: state}
+
+	res.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got domainContactsModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.Admin != nil || got.Billing != nil {
+		t.Error("admin and billing match the registrant and should stay left out")
+	}
+
+	if got.Tech == nil || got.Tech.Email.ValueString() != "ops@example.com" {
+		t.Errorf("tech = %+v, want the drifted contact", got.Tech)
+	}
+}
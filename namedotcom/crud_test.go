@@ -249,6 +249,79 @@ func TestDeleteRecordAPI_APIError(t *testing.T) {
 	}
 }
 
+// TestListRecordsAPI_FollowsPages confirms every page is fetched until the API
+// reports no next page.
+func TestListRecordsAPI_FollowsPages(t *testing.T) {
+	initLimiters(t)
+
+	calls := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com/records", func(writer http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		writer.Header().Set("Content-Type", "application/json")
+
+		if calls == 1 {
+			fmt.Fprint(writer, `{"records":[{"id":1,"host":"a","type":"A","answer":"192.0.2.1"}],"nextPage":2,"lastPage":2}`)
+
+			return
+		}
+
+		fmt.Fprint(writer, `{"records":[{"id":2,"host":"b","type":"A","answer":"192.0.2.2"}],"lastPage":2}`)
+	})
+
+	client := newMockClient(t, mux)
+
+	records, err := namedotcom.ListRecordsAPI(context.Background(), client, testDomain)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(records) != 2 || calls != 2 {
+		t.Errorf("got %d records in %d calls, want 2 records in 2 calls", len(records), calls)
+	}
+}
+
+// TestListRecordsAPI_StopsOnRepeatedPage guards against an endless loop when the
+// server keeps answering with the same page (the SDK does not send ?page=), and
+// confirms the truncated list is reported as an error rather than returned.
+func TestListRecordsAPI_StopsOnRepeatedPage(t *testing.T) {
+	initLimiters(t)
+
+	calls := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com/records", func(writer http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"records":[{"id":1,"host":"a","type":"A","answer":"192.0.2.1"}],"nextPage":2,"lastPage":3}`)
+	})
+
+	client := newMockClient(t, mux)
+
+	records, err := namedotcom.ListRecordsAPI(context.Background(), client, testDomain)
+	if err == nil {
+		t.Fatalf("expected an error for an incomplete list, got %d records", len(records))
+	}
+
+	if calls != 2 {
+		t.Errorf("ListRecords called %d times, want 2", calls)
+	}
+}
+
+func TestListRecordsAPI_APIError(t *testing.T) {
+	initLimiters(t)
+
+	client := newErrorMock(t, "/v4/domains/example.com/records")
+
+	_, err := namedotcom.ListRecordsAPI(context.Background(), client, testDomain)
+	if err == nil {
+		t.Fatal("expected error from API, got nil")
+	}
+}
+
 // DNSSEC API helper tests.
 
 func testDNSSECResponse() *namecom.DNSSEC {
@@ -413,7 +486,7 @@ func TestSetNameserversAPI_APIError(t *testing.T) {
 	}
 }
 
-func TestReadNameserversAPI_Success(t *testing.T) {
+func TestReadDomainAPI_Success(t *testing.T) {
 	initLimiters(t)
 
 	mux := http.NewServeMux()
@@ -424,7 +497,7 @@ func TestReadNameserversAPI_Success(t *testing.T) {
 
 	client := newMockClient(t, mux)
 
-	domain, found, err := namedotcom.ReadNameserversAPI(context.Background(), client, testDomain)
+	domain, found, err := namedotcom.ReadDomainAPI(context.Background(), client, testDomain)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -438,7 +511,7 @@ func TestReadNameserversAPI_Success(t *testing.T) {
 	}
 }
 
-func TestReadNameserversAPI_DomainNotFound(t *testing.T) {
+func TestReadDomainAPI_DomainNotFound(t *testing.T) {
 	initLimiters(t)
 
 	mux := http.NewServeMux()
@@ -448,7 +521,7 @@ func TestReadNameserversAPI_DomainNotFound(t *testing.T) {
 
 	client := newMockClient(t, mux)
 
-	domain, found, err := namedotcom.ReadNameserversAPI(context.Background(), client, testDomain)
+	domain, found, err := namedotcom.ReadDomainAPI(context.Background(), client, testDomain)
 	if err != nil {
 		t.Fatalf("expected nil error for not-found domain, got: %v", err)
 	}
@@ -462,12 +535,12 @@ func TestReadNameserversAPI_DomainNotFound(t *testing.T) {
 	}
 }
 
-func TestReadNameserversAPI_APIError(t *testing.T) {
+func TestReadDomainAPI_APIError(t *testing.T) {
 	initLimiters(t)
 
 	client := newErrorMock(t, "/v4/domains/example.com")
 
-	_, _, err := namedotcom.ReadNameserversAPI(context.Background(), client, testDomain)
+	_, _, err := namedotcom.ReadDomainAPI(context.Background(), client, testDomain)
 	if err == nil {
 		t.Fatal("expected error from API, got nil")
 	}
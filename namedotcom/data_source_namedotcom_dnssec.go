@@ -0,0 +1,126 @@
+package namedotcom
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource              = (*dnssecDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*dnssecDataSource)(nil)
+)
+
+// dnssecDataSource lists the DS records registered for a domain.
+type dnssecDataSource struct {
+	client *namecom.NameCom
+}
+
+// dnssecDataSourceModel maps the DNSSEC data source schema to a Go struct.
+type dnssecDataSourceModel struct {
+	ID         types.String         `tfsdk:"id"`
+	DomainName types.String         `tfsdk:"domain_name"`
+	Keys       []registeredKeyModel `tfsdk:"keys"`
+}
+
+// registeredKeyModel maps a registered DS record to a Go struct.
+type registeredKeyModel struct {
+	KeyTag     types.Int32  `tfsdk:"key_tag"`
+	Algorithm  types.Int32  `tfsdk:"algorithm"`
+	DigestType types.Int32  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+	ImportID   types.String `tfsdk:"import_id"`
+}
+
+// NewDNSSECDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewDNSSECDataSource() datasource.DataSource {
+	return &dnssecDataSource{}
+}
+
+func (d *dnssecDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (d *dnssecDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the DS records registered for a domain at Name.com.",
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:    true,
+				Description: descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				Required:    true,
+				Description: "DomainName is the domain whose DS records are listed.",
+			},
+			keyKeys: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyKeyTag:     schema.Int32Attribute{Computed: true, Description: "KeyTag is the key tag of the DNSKEY the record refers to."},
+						keyAlgorithm:  schema.Int32Attribute{Computed: true, Description: "Algorithm identifies the algorithm of the DNSKEY."},
+						keyDigestType: schema.Int32Attribute{Computed: true, Description: "DigestType identifies the algorithm used to create the digest."},
+						keyDigest:     schema.StringAttribute{Computed: true, Description: "Digest is the digest of the DNSKEY, as Name.com reports it."},
+						keyImportID:   schema.StringAttribute{Computed: true, Description: "ImportID is the identifier to import the record as a namedotcom_dnssec resource, the domain name and digest separated by an underscore."},
+					},
+				},
+				Description: "Keys are the registered DS records, sorted by key tag and digest.",
+			},
+		},
+	}
+}
+
+func (d *dnssecDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnssecDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config dnssecDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := config.DomainName.ValueString()
+
+	registered, err := listDNSSECsAPI(ctx, d.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing DNSSEC keys", err.Error())
+
+		return
+	}
+
+	slices.SortFunc(registered, func(a, b *namecom.DNSSEC) int {
+		return cmp.Or(cmp.Compare(a.KeyTag, b.KeyTag), cmp.Compare(digestKey(a.Digest), digestKey(b.Digest)))
+	})
+
+	config.ID = config.DomainName
+	config.Keys = make([]registeredKeyModel, 0, len(registered))
+
+	for _, dnssec := range registered {
+		config.Keys = append(config.Keys, registeredKeyModel{
+			KeyTag:     types.Int32Value(dnssec.KeyTag),
+			Algorithm:  types.Int32Value(dnssec.Algorithm),
+			DigestType: types.Int32Value(dnssec.DigestType),
+			Digest:     types.StringValue(dnssec.Digest),
+			ImportID:   types.StringValue(domainName + "_" + dnssec.Digest),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,139 @@
+package namedotcom
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource              = (*domainAvailabilityDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*domainAvailabilityDataSource)(nil)
+)
+
+// domainAvailabilityDataSource checks whether domains can be registered, and
+// at what price.
+type domainAvailabilityDataSource struct {
+	client *namecom.NameCom
+}
+
+// domainAvailabilityModel maps the domain availability schema to a Go struct.
+type domainAvailabilityModel struct {
+	DomainNames types.List          `tfsdk:"domain_names"`
+	Results     []searchResultModel `tfsdk:"results"`
+}
+
+// searchResultModel maps a namecom.SearchResult to a Go struct.
+type searchResultModel struct {
+	DomainName    types.String  `tfsdk:"domain_name"`
+	Purchasable   types.Bool    `tfsdk:"purchasable"`
+	Premium       types.Bool    `tfsdk:"premium"`
+	PurchasePrice types.Float64 `tfsdk:"purchase_price"`
+	RenewalPrice  types.Float64 `tfsdk:"renewal_price"`
+	PurchaseType  types.String  `tfsdk:"purchase_type"`
+}
+
+// NewDomainAvailabilityDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewDomainAvailabilityDataSource() datasource.DataSource {
+	return &domainAvailabilityDataSource{}
+}
+
+func (d *domainAvailabilityDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_availability"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (d *domainAvailabilityDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Checks whether domains can be registered with Name.com, and at what price.",
+		Attributes: map[string]schema.Attribute{
+			keyDomainNames: schema.ListAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Validators:  []validator.List{listvalidator.SizeAtLeast(1)},
+				Description: "DomainNames are the punycode encoded domain names to check. They are checked in batches of 50.",
+			},
+			keyResults: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: searchResultAttributes(),
+				},
+				Description: "Results holds one result per domain name, in the order of domain_names.",
+			},
+		},
+	}
+}
+
+// searchResultAttributes returns the attributes describing a namecom.SearchResult.
+//
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func searchResultAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		keyDomainName:    schema.StringAttribute{Computed: true, Description: "DomainName is the punycode encoded domain name."},
+		keyPurchasable:   schema.BoolAttribute{Computed: true, Description: "Purchasable is true when the domain can be registered."},
+		keyPremium:       schema.BoolAttribute{Computed: true, Description: "Premium is true when the registry prices the domain as premium."},
+		keyPurchasePrice: schema.Float64Attribute{Computed: true, Description: "PurchasePrice is the price, in USD, of registering the domain for 1 year."},
+		keyRenewalPrice:  schema.Float64Attribute{Computed: true, Description: "RenewalPrice is the annual renewal price of the domain, in USD, which may differ from the purchase price."},
+		keyPurchaseType:  schema.StringAttribute{Computed: true, Description: "PurchaseType is the kind of purchase Name.com offers for the domain, such as registration."},
+	}
+}
+
+func (d *domainAvailabilityDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *domainAvailabilityDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config domainAvailabilityModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var domainNames []string
+
+	resp.Diagnostics.Append(config.DomainNames.ElementsAs(ctx, &domainNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	results, err := checkAvailabilityBatchAPI(ctx, d.client, domainNames)
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking domain availability", err.Error())
+
+		return
+	}
+
+	config.Results = make([]searchResultModel, 0, len(results))
+
+	for _, result := range results {
+		config.Results = append(config.Results, flattenSearchResult(result))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// flattenSearchResult converts an availability result into its model.
+func flattenSearchResult(result *namecom.SearchResult) searchResultModel {
+	return searchResultModel{
+		DomainName:    types.StringValue(result.DomainName),
+		Purchasable:   types.BoolValue(result.Purchasable),
+		Premium:       types.BoolValue(result.Premium),
+		PurchasePrice: types.Float64Value(result.PurchasePrice),
+		RenewalPrice:  types.Float64Value(result.RenewalPrice),
+		PurchaseType:  types.StringValue(purchaseTypeOf(result)),
+	}
+}
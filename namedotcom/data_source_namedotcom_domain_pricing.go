@@ -0,0 +1,139 @@
+package namedotcom
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource              = (*domainPricingDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*domainPricingDataSource)(nil)
+)
+
+// domainPricingDataSource looks up what registering, renewing and
+// transferring a domain costs for a number of years.
+type domainPricingDataSource struct {
+	client *namecom.NameCom
+}
+
+// domainPricingModel maps the domain pricing schema to a Go struct.
+type domainPricingModel struct {
+	DomainName    types.String  `tfsdk:"domain_name"`
+	Years         types.Int32   `tfsdk:"years"`
+	Purchasable   types.Bool    `tfsdk:"purchasable"`
+	Premium       types.Bool    `tfsdk:"premium"`
+	PurchasePrice types.Float64 `tfsdk:"purchase_price"`
+	RenewalPrice  types.Float64 `tfsdk:"renewal_price"`
+	PurchaseType  types.String  `tfsdk:"purchase_type"`
+	TransferPrice types.Float64 `tfsdk:"transfer_price"`
+}
+
+// NewDomainPricingDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewDomainPricingDataSource() datasource.DataSource {
+	return &domainPricingDataSource{}
+}
+
+func (d *domainPricingDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_pricing"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (d *domainPricingDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Looks up what registering, renewing and transferring a domain costs with Name.com for a number of years.",
+		Attributes: map[string]schema.Attribute{
+			keyDomainName: schema.StringAttribute{
+				Required:    true,
+				Description: "DomainName is the punycode encoded domain name to price.",
+			},
+			keyYears: schema.Int32Attribute{
+				Optional:    true,
+				Validators:  []validator.Int32{int32validator.Between(registrationYearsMin, registrationYearsMax)},
+				Description: "Years is the period to price, 1-10 years. Defaults to 1.",
+			},
+			keyPurchasable:   schema.BoolAttribute{Computed: true, Description: "Purchasable is true when the domain can be registered."},
+			keyPremium:       schema.BoolAttribute{Computed: true, Description: "Premium is true when the registry prices the domain as premium."},
+			keyPurchasePrice: schema.Float64Attribute{Computed: true, Description: "PurchasePrice is the price, in USD, of registering the domain for the given years."},
+			keyRenewalPrice:  schema.Float64Attribute{Computed: true, Description: "RenewalPrice is the price, in USD, of renewing the domain for the given years."},
+			keyPurchaseType:  schema.StringAttribute{Computed: true, Description: "PurchaseType is the kind of purchase Name.com offers for the domain, such as registration."},
+			keyTransferPrice: schema.Float64Attribute{Computed: true, Description: "TransferPrice is the price, in USD, of transferring the domain to Name.com. It always covers 1 year."},
+		},
+	}
+}
+
+func (d *domainPricingDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+// Read takes the prices from GetPricingForDomain and whether and how the
+// domain can be bought from CheckAvailability, which GetPricingForDomain does
+// not report. The SDK does not forward the years query parameter, so the
+// 1-year prices are multiplied by years here, as for the domain resource.
+func (d *domainPricingDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config domainPricingModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := config.DomainName.ValueString()
+
+	years := int32(registrationYearsMin)
+	if !config.Years.IsNull() {
+		years = config.Years.ValueInt32()
+	}
+
+	pricing, err := getPricingAPI(ctx, d.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error pricing domain", err.Error())
+
+		return
+	}
+
+	result, err := checkAvailabilityAPI(ctx, d.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking domain availability", err.Error())
+
+		return
+	}
+
+	config.Purchasable = types.BoolValue(result.Purchasable)
+	config.Premium = types.BoolValue(pricing.Premium)
+	config.PurchasePrice = types.Float64Value(totalPrice(pricing.PurchasePrice, years))
+	config.RenewalPrice = types.Float64Value(totalPrice(pricing.RenewalPrice, years))
+	config.PurchaseType = types.StringValue(purchaseTypeOf(result))
+	config.TransferPrice = types.Float64Value(pricing.TransferPrice)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// getPricingAPI fetches the 1-year prices of a domain via the Name.com API.
+func getPricingAPI(ctx context.Context, client *namecom.NameCom, domainName string) (*namecom.PricingResponse, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "rate limiting error")
+	}
+
+	resp, err := client.GetPricingForDomain(&namecom.PricingRequest{DomainName: domainName})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error GetPricingForDomain")
+	}
+
+	return resp, nil
+}
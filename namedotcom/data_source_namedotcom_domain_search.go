@@ -0,0 +1,158 @@
+package namedotcom
+
+import (
+	"cmp"
+	"context"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Search timeouts Name.com accepts, in milliseconds.
+const (
+	searchTimeoutMin = 500
+	searchTimeoutMax = 5000
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource              = (*domainSearchDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*domainSearchDataSource)(nil)
+)
+
+// domainSearchDataSource suggests domain names for a keyword.
+type domainSearchDataSource struct {
+	client *namecom.NameCom
+}
+
+// domainSearchModel maps the domain search schema to a Go struct.
+type domainSearchModel struct {
+	Keyword   types.String        `tfsdk:"keyword"`
+	TLDFilter types.List          `tfsdk:"tld_filter"`
+	Timeout   types.Int32         `tfsdk:"timeout"`
+	Results   []searchResultModel `tfsdk:"results"`
+}
+
+// NewDomainSearchDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewDomainSearchDataSource() datasource.DataSource {
+	return &domainSearchDataSource{}
+}
+
+func (d *domainSearchDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_search"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (d *domainSearchDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Suggests domain names for a keyword, with their availability and prices.",
+		Attributes: map[string]schema.Attribute{
+			keyKeyword: schema.StringAttribute{
+				Required:    true,
+				Description: "Keyword is the search term: a word or a whole domain name.",
+			},
+			keyTLDFilter: schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "TLDFilter limits the results to these TLDs, given without the leading dot, such as com.",
+			},
+			keyTimeout: schema.Int32Attribute{
+				Optional:    true,
+				Validators:  []validator.Int32{int32validator.Between(searchTimeoutMin, searchTimeoutMax)},
+				Description: "Timeout is how long, in milliseconds, the registries are searched for, 500-5000. Defaults to 1000. A longer timeout finds more results.",
+			},
+			keyResults: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: searchResultAttributes(),
+				},
+				Description: "Results are the suggested domain names, sorted by domain name so the order does not change between plans.",
+			},
+		},
+	}
+}
+
+func (d *domainSearchDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *domainSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config domainSearchModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var tlds []string
+
+	if !config.TLDFilter.IsNull() {
+		resp.Diagnostics.Append(config.TLDFilter.ElementsAs(ctx, &tlds, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	results, err := searchAPI(ctx, d.client, &namecom.SearchRequest{
+		Keyword:   config.Keyword.ValueString(),
+		TldFilter: tlds,
+		Timeout:   config.Timeout.ValueInt32(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error searching domains", err.Error())
+
+		return
+	}
+
+	config.Results = make([]searchResultModel, 0, len(results))
+
+	for _, result := range sortSearchResults(results) {
+		config.Results = append(config.Results, flattenSearchResult(result))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// sortSearchResults orders search results by domain name and drops repeated
+// names, since registries answer in whatever order they finish in.
+func sortSearchResults(results []*namecom.SearchResult) []*namecom.SearchResult {
+	sorted := slices.Clone(results)
+
+	slices.SortStableFunc(sorted, func(a, b *namecom.SearchResult) int {
+		return cmp.Compare(hostKey(a.DomainName), hostKey(b.DomainName))
+	})
+
+	return slices.CompactFunc(sorted, func(a, b *namecom.SearchResult) bool {
+		return hostKey(a.DomainName) == hostKey(b.DomainName)
+	})
+}
+
+// searchAPI searches for domain names via the Name.com API. It uses Search
+// rather than SearchStream: the SDK's SearchStream decodes only the first
+// result of the stream.
+func searchAPI(ctx context.Context, client *namecom.NameCom, request *namecom.SearchRequest) ([]*namecom.SearchResult, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "rate limiting error")
+	}
+
+	resp, err := client.Search(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error Search")
+	}
+
+	return resp.Results, nil
+}
@@ -0,0 +1,267 @@
+package namedotcom
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource                   = (*expiringDomainsDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*expiringDomainsDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*expiringDomainsDataSource)(nil)
+)
+
+// expireDateLayouts are the layouts tried, in order, when parsing the expiry
+// date of a domain. Name.com documents RFC 3339, but dates without a zone or
+// time of day have been seen too; those are taken as UTC.
+var expireDateLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// expiringDomainsDataSource lists the account domains that expire soon.
+type expiringDomainsDataSource struct {
+	client *namecom.NameCom
+}
+
+// expiringDomainsModel maps the expiring domains schema to a Go struct.
+type expiringDomainsModel struct {
+	Days              types.Int32           `tfsdk:"days"`
+	Now               types.String          `tfsdk:"now"`
+	Domains           []expiringDomainModel `tfsdk:"domains"`
+	RenewalPriceTotal types.Float64         `tfsdk:"renewal_price_total"`
+}
+
+// expiringDomainModel maps an expiring domain to a Go struct.
+type expiringDomainModel struct {
+	DomainName       types.String  `tfsdk:"domain_name"`
+	ExpireDate       types.String  `tfsdk:"expire_date"`
+	DaysLeft         types.Int32   `tfsdk:"days_left"`
+	AutorenewEnabled types.Bool    `tfsdk:"autorenew_enabled"`
+	Locked           types.Bool    `tfsdk:"locked"`
+	RenewalPrice     types.Float64 `tfsdk:"renewal_price"`
+	AtRisk           types.Bool    `tfsdk:"at_risk"`
+}
+
+// NewExpiringDomainsDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewExpiringDomainsDataSource() datasource.DataSource {
+	return &expiringDomainsDataSource{}
+}
+
+func (d *expiringDomainsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_expiring_domains"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (d *expiringDomainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the domains of the Name.com account that expire within a number of days, flagging those that will not renew or are unlocked.",
+		Attributes: map[string]schema.Attribute{
+			keyDays: schema.Int32Attribute{
+				Required:    true,
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Description: "Days is how far ahead to look: domains expiring within this many days are listed, along with those already expired.",
+			},
+			keyNow: schema.StringAttribute{
+				Optional:    true,
+				Description: "Now is the RFC 3339 timestamp the days are counted from. Defaults to the time of the read; set it to get the same answer on every run.",
+			},
+			keyDomains: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyDomainName:       schema.StringAttribute{Computed: true, Description: "DomainName is the punycode encoded domain name."},
+						keyExpireDate:       schema.StringAttribute{Computed: true, Description: "ExpireDate is when the domain expires, as Name.com reports it."},
+						"days_left":         schema.Int32Attribute{Computed: true, Description: "DaysLeft is how many whole days are left before the domain expires, negative once it has."},
+						keyAutorenewEnabled: schema.BoolAttribute{Computed: true, Description: "AutorenewEnabled is true when Name.com renews the domain before it expires."},
+						keyLocked:           schema.BoolAttribute{Computed: true, Description: "Locked is true when the registrar transfer lock is on."},
+						keyRenewalPrice:     schema.Float64Attribute{Computed: true, Description: "RenewalPrice is the annual renewal price of the domain, in USD."},
+						"at_risk":           schema.BoolAttribute{Computed: true, Description: "AtRisk is true when autorenew is off or the domain is unlocked."},
+					},
+				},
+				Description: "Domains are the expiring domains, soonest first.",
+			},
+			keyRenewalPriceTotal: schema.Float64Attribute{
+				Computed:    true,
+				Description: "RenewalPriceTotal is what renewing every listed domain for 1 year costs, in USD.",
+			},
+		},
+	}
+}
+
+func (d *expiringDomainsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig rejects a now that is not an RFC 3339 timestamp.
+func (d *expiringDomainsDataSource) ValidateConfig(
+	ctx context.Context,
+	req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse,
+) {
+	var now types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyNow), &now)...)
+
+	if now.IsNull() || now.IsUnknown() {
+		return
+	}
+
+	_, err := time.Parse(time.RFC3339, now.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyNow),
+			"Invalid now",
+			fmt.Sprintf("now must be an RFC 3339 timestamp such as 2026-01-01T00:00:00Z, got %q.", now.ValueString()),
+		)
+	}
+}
+
+func (d *expiringDomainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config expiringDomainsModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now()
+	if !config.Now.IsNull() {
+		now, _ = time.Parse(time.RFC3339, config.Now.ValueString())
+	}
+
+	domains, err := listDomainsAPI(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing domains", err.Error())
+
+		return
+	}
+
+	expiring, unparsed := expiringDomains(domains, now, config.Days.ValueInt32())
+
+	if len(unparsed) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unreadable expiry dates",
+			fmt.Sprintf("The expiry dates of %s could not be parsed, so they are left out.", strings.Join(unparsed, ", ")),
+		)
+	}
+
+	total := 0.0
+	for _, domain := range expiring {
+		total += domain.RenewalPrice.ValueFloat64()
+	}
+
+	config.Domains = expiring
+	config.RenewalPriceTotal = types.Float64Value(totalPrice(total, 1))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// expiringDomains returns the domains expiring within days of now, soonest
+// first, and the names of the domains whose expiry date cannot be parsed.
+func expiringDomains(domains []*namecom.Domain, now time.Time, days int32) ([]expiringDomainModel, []string) {
+	const day = 24 * time.Hour
+
+	type expiring struct {
+		model  expiringDomainModel
+		expire time.Time
+	}
+
+	var (
+		found    []expiring
+		unparsed []string
+	)
+
+	for _, domain := range domains {
+		expire, err := parseExpireDate(domain.ExpireDate)
+		if err != nil {
+			unparsed = append(unparsed, domain.DomainName)
+
+			continue
+		}
+
+		left := int32(math.Floor(float64(expire.Sub(now)) / float64(day)))
+		if left >= days {
+			continue
+		}
+
+		found = append(found, expiring{
+			model: expiringDomainModel{
+				DomainName:       types.StringValue(domain.DomainName),
+				ExpireDate:       types.StringValue(domain.ExpireDate),
+				DaysLeft:         types.Int32Value(left),
+				AutorenewEnabled: types.BoolValue(domain.AutorenewEnabled),
+				Locked:           types.BoolValue(domain.Locked),
+				RenewalPrice:     types.Float64Value(domain.RenewalPrice),
+				AtRisk:           types.BoolValue(!domain.AutorenewEnabled || !domain.Locked),
+			},
+			expire: expire,
+		})
+	}
+
+	slices.SortStableFunc(found, func(a, b expiring) int {
+		return cmp.Or(a.expire.Compare(b.expire), strings.Compare(a.model.DomainName.ValueString(), b.model.DomainName.ValueString()))
+	})
+
+	models := make([]expiringDomainModel, 0, len(found))
+	for _, domain := range found {
+		models = append(models, domain.model)
+	}
+
+	return models, unparsed
+}
+
+// parseExpireDate parses an expiry date in any of expireDateLayouts. The
+// orders data source parses creation dates with it too, as Name.com formats
+// them the same way.
+func parseExpireDate(value string) (time.Time, error) {
+	value = strings.TrimSpace(value)
+
+	for _, layout := range expireDateLayouts {
+		parsed, err := time.Parse(layout, value)
+		if err == nil {
+			return parsed, nil
+		}
+	}
+
+	return time.Time{}, errors.Newf("unrecognized date %q", value)
+}
+
+// listDomainsAPI fetches every domain in the account via the Name.com API.
+func listDomainsAPI(ctx context.Context, client *namecom.NameCom) ([]*namecom.Domain, error) {
+	return listAllPages(ctx, "ListDomains",
+		func(page int32) ([]*namecom.Domain, int32, error) {
+			resp, err := client.ListDomains(&namecom.ListDomainsRequest{Page: page})
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return resp.Domains, resp.NextPage, nil
+		},
+		func(domain *namecom.Domain) string { return domain.DomainName },
+	)
+}
@@ -0,0 +1,109 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource              = (*orderDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*orderDataSource)(nil)
+)
+
+// orderDataSource reads a single order, including its items.
+type orderDataSource struct {
+	client *namecom.NameCom
+}
+
+// orderDataSourceModel maps the order schema to a Go struct: the order ID to
+// read, followed by the order's attributes.
+type orderDataSourceModel struct {
+	OrderID types.Int32 `tfsdk:"order_id"`
+	orderModel
+}
+
+// NewOrderDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewOrderDataSource() datasource.DataSource {
+	return &orderDataSource{}
+}
+
+func (d *orderDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_order"
+}
+
+func (d *orderDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := orderAttributes()
+	attributes[keyOrderID] = schema.Int32Attribute{
+		Required:    true,
+		Description: "OrderID identifies the order to read, such as the order_id of a namedotcom_domain.",
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Reads an order of the Name.com account, including its items, prices and refunds.",
+		Attributes:  attributes,
+	}
+}
+
+func (d *orderDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *orderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config orderDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	order, err := getOrderAPI(ctx, d.client, config.OrderID.ValueInt32())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(keyOrderID),
+				"Order not found",
+				fmt.Sprintf("Order %d does not exist in this Name.com account.", config.OrderID.ValueInt32()),
+			)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Error reading order", err.Error())
+
+		return
+	}
+
+	config.orderModel = flattenOrder(order)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// getOrderAPI fetches an order via the Name.com API.
+func getOrderAPI(ctx context.Context, client *namecom.NameCom, orderID int32) (*namecom.Order, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "rate limiting error")
+	}
+
+	order, err := client.GetOrder(&namecom.GetOrderRequest{OrderID: orderID})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error GetOrder")
+	}
+
+	return order, nil
+}
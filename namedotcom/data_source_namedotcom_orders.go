@@ -0,0 +1,344 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource                   = (*ordersDataSource)(nil)
+	_ datasource.DataSourceWithConfigure      = (*ordersDataSource)(nil)
+	_ datasource.DataSourceWithValidateConfig = (*ordersDataSource)(nil)
+)
+
+// ordersDataSource lists the orders of the account, optionally filtered.
+type ordersDataSource struct {
+	client *namecom.NameCom
+}
+
+// ordersModel maps the orders schema to a Go struct.
+type ordersModel struct {
+	CreatedAfter  types.String `tfsdk:"created_after"`
+	CreatedBefore types.String `tfsdk:"created_before"`
+	Status        types.String `tfsdk:"status"`
+	ItemType      types.String `tfsdk:"item_type"`
+	Orders        []orderModel `tfsdk:"orders"`
+}
+
+// orderModel maps a namecom.Order to a Go struct.
+type orderModel struct {
+	ID           types.Int32      `tfsdk:"id"`
+	CreateDate   types.String     `tfsdk:"create_date"`
+	Status       types.String     `tfsdk:"status"`
+	Currency     types.String     `tfsdk:"currency"`
+	AuthAmount   types.Float64    `tfsdk:"auth_amount"`
+	TotalCapture types.Float64    `tfsdk:"total_capture"`
+	TotalRefund  types.Float64    `tfsdk:"total_refund"`
+	FinalAmount  types.String     `tfsdk:"final_amount"`
+	Items        []orderItemModel `tfsdk:"items"`
+}
+
+// orderItemModel maps a namecom.OrderItem to a Go struct.
+type orderItemModel struct {
+	ID            types.Int32   `tfsdk:"id"`
+	Status        types.String  `tfsdk:"status"`
+	Name          types.String  `tfsdk:"name"`
+	TLD           types.String  `tfsdk:"tld"`
+	Type          types.String  `tfsdk:"type"`
+	Price         types.Float64 `tfsdk:"price"`
+	OriginalPrice types.Float64 `tfsdk:"original_price"`
+	TaxAmount     types.Float64 `tfsdk:"tax_amount"`
+	Quantity      types.Int32   `tfsdk:"quantity"`
+	Duration      types.Int32   `tfsdk:"duration"`
+	Interval      types.String  `tfsdk:"interval"`
+}
+
+// NewOrdersDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewOrdersDataSource() datasource.DataSource {
+	return &ordersDataSource{}
+}
+
+func (d *ordersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_orders"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (d *ordersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the orders of the Name.com account, optionally filtered by date, status and item type.",
+		Attributes: map[string]schema.Attribute{
+			keyCreatedAfter: schema.StringAttribute{
+				Optional:    true,
+				Description: "CreatedAfter keeps only orders placed at or after this RFC 3339 timestamp, such as 2026-01-01T00:00:00Z.",
+			},
+			keyCreatedBefore: schema.StringAttribute{
+				Optional:    true,
+				Description: "CreatedBefore keeps only orders placed before this RFC 3339 timestamp.",
+			},
+			keyStatus: schema.StringAttribute{
+				Optional:    true,
+				Description: "Status keeps only orders with this status, such as success or failed. Matched case-insensitively.",
+			},
+			keyItemType: schema.StringAttribute{
+				Optional:    true,
+				Description: "ItemType keeps only orders with at least one item of this type, such as registration, renewal, transfer or whois_privacy. Matched case-insensitively.",
+			},
+			keyOrders: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: orderAttributes(),
+				},
+				Description: "Orders are the matching orders, in the order Name.com lists them.",
+			},
+		},
+	}
+}
+
+// orderAttributes returns the attributes describing a namecom.Order.
+//
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func orderAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		keyID:           schema.Int32Attribute{Computed: true, Description: "ID identifies the order."},
+		keyCreateDate:   schema.StringAttribute{Computed: true, Description: "CreateDate is when the order was placed."},
+		keyStatus:       schema.StringAttribute{Computed: true, Description: "Status is the state of the order, such as success or failed."},
+		"currency":      schema.StringAttribute{Computed: true, Description: "Currency is the currency of the order, such as USD."},
+		"auth_amount":   schema.Float64Attribute{Computed: true, Description: "AuthAmount is the amount authorized to pay for the order."},
+		"total_capture": schema.Float64Attribute{Computed: true, Description: "TotalCapture is the amount charged."},
+		"total_refund":  schema.Float64Attribute{Computed: true, Description: "TotalRefund is the amount refunded, 0 when nothing was."},
+		"final_amount":  schema.StringAttribute{Computed: true, Description: "FinalAmount is the amount of the order after discounts and refunds, as Name.com reports it."},
+		"items": schema.ListNestedAttribute{
+			Computed: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					keyID:            schema.Int32Attribute{Computed: true, Description: "ID identifies the order item."},
+					keyStatus:        schema.StringAttribute{Computed: true, Description: "Status is the state of the item, such as success, failed or refunded."},
+					"name":           schema.StringAttribute{Computed: true, Description: "Name is what was bought, such as the domain name."},
+					"tld":            schema.StringAttribute{Computed: true, Description: "TLD is the TLD of the domain, when the item is for a domain."},
+					"type":           schema.StringAttribute{Computed: true, Description: "Type is the kind of item, such as registration or whois_privacy."},
+					"price":          schema.Float64Attribute{Computed: true, Description: "Price is the final price of the item."},
+					"original_price": schema.Float64Attribute{Computed: true, Description: "OriginalPrice is the price of the item before discounts."},
+					"tax_amount":     schema.Float64Attribute{Computed: true, Description: "TaxAmount is the tax charged for the item."},
+					"quantity":       schema.Int32Attribute{Computed: true, Description: "Quantity is how many of the item were bought."},
+					"duration":       schema.Int32Attribute{Computed: true, Description: "Duration is how many intervals the item covers."},
+					"interval":       schema.StringAttribute{Computed: true, Description: "Interval is the unit of duration, such as year."},
+				},
+			},
+			Description: "Items are the things bought in the order.",
+		},
+	}
+}
+
+func (d *ordersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+// ValidateConfig rejects date filters that are not RFC 3339 timestamps.
+func (d *ordersDataSource) ValidateConfig(
+	ctx context.Context,
+	req datasource.ValidateConfigRequest,
+	resp *datasource.ValidateConfigResponse,
+) {
+	for _, key := range []string{keyCreatedAfter, keyCreatedBefore} {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(key), &value)...)
+
+		if value.IsNull() || value.IsUnknown() {
+			continue
+		}
+
+		_, err := time.Parse(time.RFC3339, value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(key),
+				"Invalid "+key,
+				fmt.Sprintf("%s must be an RFC 3339 timestamp such as 2026-01-01T00:00:00Z, got %q.", key, value.ValueString()),
+			)
+		}
+	}
+}
+
+func (d *ordersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ordersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	orders, err := listOrdersAPI(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing orders", err.Error())
+
+		return
+	}
+
+	config.Orders = []orderModel{}
+
+	var unparsed []string
+
+	for _, order := range orders {
+		matched, err := config.matches(order)
+		if err != nil {
+			unparsed = append(unparsed, strconv.Itoa(int(order.ID)))
+
+			continue
+		}
+
+		if !matched {
+			continue
+		}
+
+		// ListOrders may leave out the items; they are needed to filter on
+		// item type.
+		if !config.ItemType.IsNull() && len(order.OrderItems) == 0 {
+			order, err = getOrderAPI(ctx, d.client, order.ID)
+			if err != nil {
+				resp.Diagnostics.AddError("Error reading order", err.Error())
+
+				return
+			}
+		}
+
+		if !config.hasItemType(order) {
+			continue
+		}
+
+		config.Orders = append(config.Orders, flattenOrder(order))
+	}
+
+	if len(unparsed) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Unreadable order dates",
+			fmt.Sprintf("The creation dates of orders %s could not be parsed, so they are left out of the date filter.",
+				strings.Join(unparsed, ", ")),
+		)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// matches reports whether an order passes the date and status filters. With a
+// date filter set, an order whose date cannot be parsed is an error, so the
+// caller can report it rather than drop it silently.
+func (m *ordersModel) matches(order *namecom.Order) (bool, error) {
+	if !m.Status.IsNull() && !strings.EqualFold(order.Status, m.Status.ValueString()) {
+		return false, nil
+	}
+
+	if m.CreatedAfter.IsNull() && m.CreatedBefore.IsNull() {
+		return true, nil
+	}
+
+	created, err := parseExpireDate(order.CreateDate)
+	if err != nil {
+		return false, err
+	}
+
+	if !m.CreatedAfter.IsNull() {
+		after, _ := time.Parse(time.RFC3339, m.CreatedAfter.ValueString())
+		if created.Before(after) {
+			return false, nil
+		}
+	}
+
+	if !m.CreatedBefore.IsNull() {
+		before, _ := time.Parse(time.RFC3339, m.CreatedBefore.ValueString())
+		if !created.Before(before) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// hasItemType reports whether an order passes the item type filter.
+func (m *ordersModel) hasItemType(order *namecom.Order) bool {
+	if m.ItemType.IsNull() {
+		return true
+	}
+
+	for _, item := range order.OrderItems {
+		if strings.EqualFold(item.Type, m.ItemType.ValueString()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// flattenOrder converts an order into its model.
+func flattenOrder(order *namecom.Order) orderModel {
+	items := make([]orderItemModel, 0, len(order.OrderItems))
+
+	for _, item := range order.OrderItems {
+		items = append(items, orderItemModel{
+			ID:            types.Int32Value(item.ID),
+			Status:        types.StringValue(item.Status),
+			Name:          types.StringValue(item.Name),
+			TLD:           types.StringValue(item.Tld),
+			Type:          types.StringValue(item.Type),
+			Price:         orderAmount(item.Price),
+			OriginalPrice: orderAmount(item.OriginalPrice),
+			TaxAmount:     orderAmount(item.TaxAmount),
+			Quantity:      types.Int32Value(item.Quantity),
+			Duration:      types.Int32Value(item.Duration),
+			Interval:      types.StringValue(item.Interval),
+		})
+	}
+
+	return orderModel{
+		ID:           types.Int32Value(order.ID),
+		CreateDate:   types.StringValue(order.CreateDate),
+		Status:       types.StringValue(order.Status),
+		Currency:     types.StringValue(order.Currency),
+		AuthAmount:   orderAmount(order.AuthAmount),
+		TotalCapture: orderAmount(order.TotalCapture),
+		TotalRefund:  orderAmount(order.TotalRefund),
+		FinalAmount:  types.StringValue(order.FinalAmount),
+		Items:        items,
+	}
+}
+
+// orderAmount converts an amount the SDK decodes as float32 into a Float64
+// holding the same decimal, so 12.99 does not become 12.989999771118164.
+func orderAmount(amount float32) types.Float64 {
+	const float32Bits = 32
+
+	value, _ := strconv.ParseFloat(strconv.FormatFloat(float64(amount), 'f', -1, float32Bits), 64)
+
+	return types.Float64Value(value)
+}
+
+// listOrdersAPI fetches every order in the account via the Name.com API.
+func listOrdersAPI(ctx context.Context, client *namecom.NameCom) ([]*namecom.Order, error) {
+	return listAllPages(ctx, "ListOrders",
+		func(page int32) ([]*namecom.Order, int32, error) {
+			resp, err := client.ListOrders(&namecom.ListOrdersRequest{Page: page})
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return resp.Orders, resp.NextPage, nil
+		},
+		func(order *namecom.Order) int32 { return order.ID },
+	)
+}
@@ -0,0 +1,128 @@
+package namedotcom
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource              = (*transfersDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*transfersDataSource)(nil)
+)
+
+// transfersDataSource lists the pending inbound transfers of the account.
+type transfersDataSource struct {
+	client *namecom.NameCom
+}
+
+// transfersModel maps the transfers schema to a Go struct.
+type transfersModel struct {
+	Status    types.String           `tfsdk:"status"`
+	Transfers []pendingTransferModel `tfsdk:"transfers"`
+}
+
+// pendingTransferModel maps a namecom.Transfer to a Go struct. Name.com reports
+// no dates for a transfer, so there is none to tell how long it has been pending.
+type pendingTransferModel struct {
+	DomainName types.String `tfsdk:"domain_name"`
+	Email      types.String `tfsdk:"email"`
+	Status     types.String `tfsdk:"status"`
+}
+
+// NewTransfersDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewTransfersDataSource() datasource.DataSource {
+	return &transfersDataSource{}
+}
+
+func (d *transfersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_transfers"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (d *transfersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the pending transfers of domains into the Name.com account, optionally filtered by status. Name.com reports no transfer dates, so it cannot show how long a transfer has been pending.",
+		Attributes: map[string]schema.Attribute{
+			keyStatus: schema.StringAttribute{
+				Optional:    true,
+				Description: "Status keeps only transfers with this status, such as Pending Transfer Approval. Matched case-insensitively.",
+			},
+			keyTransfers: schema.ListNestedAttribute{
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyDomainName: schema.StringAttribute{Computed: true, Description: "DomainName is the domain being transferred."},
+						keyEmail:      schema.StringAttribute{Computed: true, Description: "Email is where the approval email was sent, empty when the TLD needs none."},
+						keyStatus:     schema.StringAttribute{Computed: true, Description: "Status is the state of the transfer, such as Pending Transfer Approval or Rejected."},
+					},
+				},
+				Description: "Transfers are the matching pending transfers, in the order Name.com lists them, without a start date.",
+			},
+		},
+	}
+}
+
+func (d *transfersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *transfersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config transfersModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transfers, err := listTransfersAPI(ctx, d.client)
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing transfers", err.Error())
+
+		return
+	}
+
+	config.Transfers = []pendingTransferModel{}
+
+	for _, transfer := range transfers {
+		if !config.Status.IsNull() && !strings.EqualFold(transfer.Status, config.Status.ValueString()) {
+			continue
+		}
+
+		config.Transfers = append(config.Transfers, pendingTransferModel{
+			DomainName: types.StringValue(transfer.DomainName),
+			Email:      types.StringValue(transfer.Email),
+			Status:     types.StringValue(transfer.Status),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listTransfersAPI fetches every pending transfer in the account via the
+// Name.com API.
+func listTransfersAPI(ctx context.Context, client *namecom.NameCom) ([]*namecom.Transfer, error) {
+	return listAllPages(ctx, "ListTransfers",
+		func(page int32) ([]*namecom.Transfer, int32, error) {
+			resp, err := client.ListTransfers(&namecom.ListTransfersRequest{Page: page})
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return resp.Transfers, resp.NextPage, nil
+		},
+		func(transfer *namecom.Transfer) string { return transfer.DomainName },
+	)
+}
@@ -0,0 +1,89 @@
+package namedotcom
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the data source satisfies the required framework interfaces.
+var (
+	_ datasource.DataSource              = (*zoneFileDataSource)(nil)
+	_ datasource.DataSourceWithConfigure = (*zoneFileDataSource)(nil)
+)
+
+// zoneFileDataSource renders the records of a domain as an RFC 1035 zone file.
+type zoneFileDataSource struct {
+	client *namecom.NameCom
+}
+
+// zoneFileModel maps the zone file schema to a Go struct.
+type zoneFileModel struct {
+	ID         types.String `tfsdk:"id"`
+	DomainName types.String `tfsdk:"domain_name"`
+	Content    types.String `tfsdk:"content"`
+}
+
+// NewZoneFileDataSource is the data source factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the datasource.DataSource interface.
+func NewZoneFileDataSource() datasource.DataSource {
+	return &zoneFileDataSource{}
+}
+
+func (d *zoneFileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_zone_file"
+}
+
+func (d *zoneFileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:    true,
+				Description: descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				Required:    true,
+				Description: "DomainName is the zone whose records are rendered.",
+			},
+			keyContent: schema.StringAttribute{
+				Computed: true,
+				//nolint:lll // One sentence describing the canonical output.
+				Description: "Content is the zone file in RFC 1035 master file format. Records are sorted and written with explicit TTLs and fully qualified targets, so the output only changes when the records do. The SOA record is not included because Name.com generates it.",
+			},
+		},
+	}
+}
+
+func (d *zoneFileDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	d.client = client
+}
+
+func (d *zoneFileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config zoneFileModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	records, err := listRecordsAPI(ctx, d.client, config.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error listing records", err.Error())
+
+		return
+	}
+
+	config.ID = config.DomainName
+	config.Content = types.StringValue(renderZoneFile(config.DomainName.ValueString(), records))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
@@ -0,0 +1,297 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// dnsSemantics defines when two strings are the same DNS value. Each
+// implementation is a zero-size marker used as the type parameter of
+// dnsStringType and dnsStringValue.
+type dnsSemantics interface {
+	// typeName names the custom type in diagnostics and String().
+	typeName() string
+	// equal reports whether two values differ only cosmetically.
+	equal(a, b string) bool
+}
+
+// hostnameSemantics compares DNS names: letter case and a trailing dot are not
+// significant, and the apex forms "@" and "" are the same host.
+type hostnameSemantics struct{}
+
+func (hostnameSemantics) typeName() string { return "HostnameType" }
+
+func (hostnameSemantics) equal(a, b string) bool {
+	return hostKey(a) == hostKey(b)
+}
+
+// recordTypeSemantics compares record types case-insensitively.
+type recordTypeSemantics struct{}
+
+func (recordTypeSemantics) typeName() string { return "RecordTypeType" }
+
+func (recordTypeSemantics) equal(a, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// ipv4Semantics compares IPv4 addresses by value.
+type ipv4Semantics struct{}
+
+func (ipv4Semantics) typeName() string { return "IPv4AddressType" }
+
+func (ipv4Semantics) equal(a, b string) bool {
+	return addressEqual(a, b, netip.Addr.Is4)
+}
+
+// ipv6Semantics compares IPv6 addresses by value, so letter case and zero
+// compression (2001:DB8:0::1 and 2001:db8::1) are not significant.
+type ipv6Semantics struct{}
+
+func (ipv6Semantics) typeName() string { return "IPv6AddressType" }
+
+func (ipv6Semantics) equal(a, b string) bool {
+	return addressEqual(a, b, netip.Addr.Is6)
+}
+
+// txtSemantics compares TXT values by their text: a value written as one or
+// more quoted character-strings is the same as the unquoted concatenation.
+type txtSemantics struct{}
+
+func (txtSemantics) typeName() string { return "TXTValueType" }
+
+func (txtSemantics) equal(a, b string) bool {
+	return txtText(a) == txtText(b)
+}
+
+// answerSemantics compares record answers exactly. Which differences in an
+// answer are cosmetic depends on record_type, which the type cannot see, so
+// the record resource applies them itself through answerEqual and
+// reconcileAnswer; the framework's own comparison must not hide real drift,
+// such as a trailing dot added to a TXT answer.
+type answerSemantics struct{}
+
+func (answerSemantics) typeName() string { return "AnswerType" }
+
+func (answerSemantics) equal(a, b string) bool {
+	return a == b
+}
+
+// answerEqual reports whether two answers of a record of recordType differ
+// only cosmetically: A and AAAA answers compare as addresses, DNS-name answers
+// (including the SRV target) ignore case and a trailing dot, and TXT answers
+// compare by their text. Answers of other types must match exactly.
+func answerEqual(recordType, a, b string) bool {
+	switch strings.ToUpper(recordType) {
+	case recordTypeA:
+		return ipv4Semantics{}.equal(a, b)
+	case recordTypeAAAA:
+		return ipv6Semantics{}.equal(a, b)
+	case recordTypeANAME, recordTypeCNAME, recordTypeMX, recordTypeNS, recordTypeSRV:
+		return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
+	case recordTypeTXT:
+		return txtSemantics{}.equal(a, b)
+	default:
+		return answerSemantics{}.equal(a, b)
+	}
+}
+
+// digestSemantics compares hex digests: letter case and whitespace are not
+// significant.
+type digestSemantics struct{}
+
+func (digestSemantics) typeName() string { return "DigestType" }
+
+func (digestSemantics) equal(a, b string) bool {
+	return strings.EqualFold(strings.Join(strings.Fields(a), ""), strings.Join(strings.Fields(b), ""))
+}
+
+// addressEqual reports whether a and b are the same address of the family
+// selected by isFamily. Values that do not parse compare as plain strings.
+func addressEqual(a, b string, isFamily func(netip.Addr) bool) bool {
+	if a == b {
+		return true
+	}
+
+	addrA, errA := netip.ParseAddr(a)
+	addrB, errB := netip.ParseAddr(b)
+
+	if errA != nil || errB != nil || !isFamily(addrA) || !isFamily(addrB) {
+		return false
+	}
+
+	return addrA == addrB
+}
+
+// txtText returns the text of a TXT value. A value made only of quoted
+// character-strings is unquoted and concatenated; anything else is returned
+// unchanged.
+func txtText(value string) string {
+	trimmed := strings.TrimSpace(value)
+	if !strings.HasPrefix(trimmed, `"`) {
+		return value
+	}
+
+	tokens, depth, err := tokenizeZoneLine(trimmed)
+	if err != nil || depth != 0 {
+		return value
+	}
+
+	var text strings.Builder
+
+	for _, token := range tokens {
+		if !token.quoted {
+			return value
+		}
+
+		text.WriteString(token.text)
+	}
+
+	return text.String()
+}
+
+// The custom types used by the schemas. Their values keep the configured
+// representation whenever the API returns a semantically equal one.
+type (
+	hostnameType    = dnsStringType[hostnameSemantics]
+	hostnameValue   = dnsStringValue[hostnameSemantics]
+	recordTypeType  = dnsStringType[recordTypeSemantics]
+	recordTypeValue = dnsStringValue[recordTypeSemantics]
+	answerType      = dnsStringType[answerSemantics]
+	answerValue     = dnsStringValue[answerSemantics]
+	digestType      = dnsStringType[digestSemantics]
+	digestValue     = dnsStringValue[digestSemantics]
+)
+
+// Ensure the custom types satisfy the framework interfaces.
+var (
+	_ basetypes.StringTypable                    = dnsStringType[hostnameSemantics]{}
+	_ basetypes.StringValuableWithSemanticEquals = dnsStringValue[hostnameSemantics]{}
+)
+
+// dnsStringType is a string type whose values compare with semantic equality S.
+type dnsStringType[S dnsSemantics] struct {
+	basetypes.StringType
+}
+
+func (t dnsStringType[S]) Equal(other attr.Type) bool {
+	typed, ok := other.(dnsStringType[S])
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(typed.StringType)
+}
+
+func (t dnsStringType[S]) String() string {
+	var semantics S
+
+	return semantics.typeName()
+}
+
+//nolint:ireturn // The framework contract requires returning the basetypes.StringValuable interface.
+func (t dnsStringType[S]) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return dnsStringValue[S]{StringValue: in}, nil
+}
+
+func (t dnsStringType[S]) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, errors.Wrap(err, "error converting string value")
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, errors.Newf("unexpected value type %T", attrValue)
+	}
+
+	return dnsStringValue[S]{StringValue: stringValue}, nil
+}
+
+//nolint:ireturn // The framework contract requires returning the attr.Value interface.
+func (t dnsStringType[S]) ValueType(_ context.Context) attr.Value {
+	return dnsStringValue[S]{}
+}
+
+// dnsStringValue is a string value with semantic equality S.
+type dnsStringValue[S dnsSemantics] struct {
+	basetypes.StringValue
+}
+
+func (v dnsStringValue[S]) Equal(other attr.Value) bool {
+	typed, ok := other.(dnsStringValue[S])
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(typed.StringValue)
+}
+
+//nolint:ireturn // The framework contract requires returning the attr.Type interface.
+func (v dnsStringValue[S]) Type(_ context.Context) attr.Type {
+	return dnsStringType[S]{}
+}
+
+// StringSemanticEquals reports whether the new value differs from v only
+// cosmetically, in which case the framework keeps v.
+func (v dnsStringValue[S]) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(dnsStringValue[S])
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T but got %T. Please report this to the provider developers.", v, newValuable),
+		)
+
+		return false, diags
+	}
+
+	return semanticEqual(v, newValue.ValueString()), diags
+}
+
+// semanticEqual reports whether a known value is semantically equal to other.
+func semanticEqual[S dnsSemantics](value dnsStringValue[S], other string) bool {
+	if value.IsNull() || value.IsUnknown() {
+		return false
+	}
+
+	var semantics S
+
+	return semantics.equal(value.ValueString(), other)
+}
+
+// dnsStringOf returns a known value of a DNS string type.
+func dnsStringOf[S dnsSemantics](value string) dnsStringValue[S] {
+	return dnsStringValue[S]{StringValue: types.StringValue(value)}
+}
+
+// reconcileDNSValue keeps the prior value when it is semantically equal to the
+// API value; otherwise it adopts the API value, so real drift is detected and
+// an empty prior (during import) is populated. The framework applies the same
+// rule to values written to state; doing it here keeps Read self-contained.
+func reconcileDNSValue[S dnsSemantics](prior dnsStringValue[S], apiValue string) dnsStringValue[S] {
+	if semanticEqual(prior, apiValue) {
+		return prior
+	}
+
+	return dnsStringOf[S](apiValue)
+}
+
+// reconcileAnswer is reconcileDNSValue for a record answer, compared with the
+// rules of the record's type (see answerEqual).
+func reconcileAnswer(prior answerValue, recordType, apiValue string) answerValue {
+	if !prior.IsNull() && !prior.IsUnknown() && answerEqual(recordType, prior.ValueString(), apiValue) {
+		return prior
+	}
+
+	return dnsStringOf[answerSemantics](apiValue)
+}
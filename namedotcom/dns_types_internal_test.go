@@ -0,0 +1,206 @@
+package namedotcom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// TestDNSSemanticEquals pins which differences each custom type treats as
+// cosmetic, through the StringSemanticEquals method the framework calls.
+func TestDNSSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		typ       basetypes.StringTypable
+		prior     string
+		proposed  string
+		wantEqual bool
+	}{
+		{"hostname case", hostnameType{}, "WWW.Example.com", "www.example.com", true},
+		{"hostname trailing dot", hostnameType{}, "ns1.example.com.", "ns1.example.com", true},
+		{"hostname apex", hostnameType{}, "@", "", true},
+		{"hostname change", hostnameType{}, "www", "mail", false},
+		{"record type case", recordTypeType{}, "cname", "CNAME", true},
+		{"record type change", recordTypeType{}, "A", "AAAA", false},
+		{"ipv4 same", dnsStringType[ipv4Semantics]{}, "192.0.2.1", "192.0.2.1", true},
+		{"ipv4 change", dnsStringType[ipv4Semantics]{}, "192.0.2.1", "192.0.2.2", false},
+		{"ipv4 rejects ipv6", dnsStringType[ipv4Semantics]{}, "::ffff:192.0.2.1", "192.0.2.1", false},
+		{"ipv6 compression and case", dnsStringType[ipv6Semantics]{}, "2001:DB8:0::1", "2001:db8::1", true},
+		{"ipv6 change", dnsStringType[ipv6Semantics]{}, "2001:db8::1", "2001:db8::2", false},
+		{"txt quoted", dnsStringType[txtSemantics]{}, `"v=spf1 -all"`, "v=spf1 -all", true},
+		{"txt chunks", dnsStringType[txtSemantics]{}, `"abc" "def"`, "abcdef", true},
+		{"txt case is significant", dnsStringType[txtSemantics]{}, "Hello", "hello", false},
+		{"answer same", answerType{}, "bar.com", "bar.com", true},
+		{"answer ipv6 is exact without the record type", answerType{}, "2001:DB8:0::1", "2001:db8::1", false},
+		{"answer trailing dot is exact without the record type", answerType{}, "abc", "abc.", false},
+		{"answer case is exact without the record type", answerType{}, "Hello", "hello", false},
+		{"answer txt is exact without the record type", answerType{}, `"hello world"`, "hello world", false},
+		{"digest case", digestType{}, "aabbccdd", "AABBCCDD", true},
+		{"digest whitespace", digestType{}, "AABB CCDD", "AABBCCDD", true},
+		{"digest change", digestType{}, "AABBCCDD", "AABBCCDE", false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			prior, diags := testCase.typ.ValueFromString(ctx, types.StringValue(testCase.prior))
+			if diags.HasError() {
+				t.Fatalf("ValueFromString: %v", diags)
+			}
+
+			proposed, diags := testCase.typ.ValueFromString(ctx, types.StringValue(testCase.proposed))
+			if diags.HasError() {
+				t.Fatalf("ValueFromString: %v", diags)
+			}
+
+			semantic, ok := prior.(basetypes.StringValuableWithSemanticEquals)
+			if !ok {
+				t.Fatalf("%T does not implement semantic equality", prior)
+			}
+
+			got, diags := semantic.StringSemanticEquals(ctx, proposed)
+			if diags.HasError() {
+				t.Fatalf("StringSemanticEquals: %v", diags)
+			}
+
+			if got != testCase.wantEqual {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", testCase.prior, testCase.proposed, got, testCase.wantEqual)
+			}
+		})
+	}
+}
+
+// TestDNSSemanticEquals_WrongTypeErrors guards against comparing values of two
+// different custom types.
+func TestDNSSemanticEquals_WrongTypeErrors(t *testing.T) {
+	t.Parallel()
+
+	_, diags := dnsStringOf[hostnameSemantics]("a").StringSemanticEquals(context.Background(), dnsStringOf[digestSemantics]("a"))
+
+	if !diags.HasError() {
+		t.Error("expected an error comparing values of different types")
+	}
+}
+
+// TestDNSStringType_RoundTrip checks the type plumbing the framework relies on:
+// values decode to the custom value type and types compare by semantics.
+func TestDNSStringType_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	value, err := hostnameType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "www"))
+	if err != nil {
+		t.Fatalf("ValueFromTerraform: %v", err)
+	}
+
+	if _, ok := value.(hostnameValue); !ok {
+		t.Fatalf("ValueFromTerraform returned %T, want hostnameValue", value)
+	}
+
+	if !value.Type(ctx).Equal(hostnameType{}) {
+		t.Error("a hostname value must report the hostname type")
+	}
+
+	for _, other := range []attr.Type{digestType{}, types.StringType} {
+		if (hostnameType{}).Equal(other) {
+			t.Errorf("hostnameType must not equal %s", other)
+		}
+	}
+
+	if value.Equal(types.StringValue("www")) {
+		t.Error("a hostname value must not equal a plain string value")
+	}
+}
+
+// TestAnswerEqual pins the record-type-aware answer comparison used by the
+// refresh and by adopt_existing.
+func TestAnswerEqual(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		recordType string
+		a, b       string
+		wantEqual  bool
+	}{
+		{"A", "192.0.2.1", "192.0.2.1", true},
+		{"A", "192.0.2.1", "192.0.2.2", false},
+		{"aaaa", "2001:DB8:0::1", "2001:db8::1", true},
+		{"CNAME", "bar.com", "BAR.com.", true},
+		{"MX", "Mail.Example.com", "mail.example.com.", true},
+		{"SRV", "10 5060 SIP.example.com", "10 5060 sip.example.com.", true},
+		{"SRV", "10 5060 sip.example.com", "10 5061 sip.example.com", false},
+		{"TXT", `"v=spf1 -all"`, "v=spf1 -all", true},
+		{"TXT", "Hello", "hello", false},
+		{"TXT", "abc", "abc.", false},
+	}
+
+	for _, testCase := range cases {
+		got := answerEqual(testCase.recordType, testCase.a, testCase.b)
+		if got != testCase.wantEqual {
+			t.Errorf("answerEqual(%q, %q, %q) = %v, want %v", testCase.recordType, testCase.a, testCase.b, got, testCase.wantEqual)
+		}
+	}
+}
+
+// TestRecordReadState_TXTCaseIsDrift checks that a TXT answer changed only in
+// letter case outside Terraform is adopted from the API, while a CNAME target
+// differing only in case keeps the configured form.
+func TestRecordReadState_TXTCaseIsDrift(t *testing.T) {
+	t.Parallel()
+
+	txtState := recordModel{
+		ID:         types.StringValue("42"),
+		DomainName: dnsStringOf[hostnameSemantics]("example.com"),
+		Host:       dnsStringOf[hostnameSemantics]("www"),
+		RecordType: dnsStringOf[recordTypeSemantics]("TXT"),
+		Answer:     dnsStringOf[answerSemantics]("Hello"),
+	}
+
+	got := recordReadState(txtState, &namecom.Record{ID: 42, DomainName: "example.com", Host: "www", Type: "TXT", Answer: "hello"})
+	if got.Answer.ValueString() != "hello" {
+		t.Errorf("TXT answer = %q, want the API's %q", got.Answer.ValueString(), "hello")
+	}
+
+	cnameState := txtState
+	cnameState.RecordType = dnsStringOf[recordTypeSemantics]("CNAME")
+	cnameState.Answer = dnsStringOf[answerSemantics]("BAR.com")
+
+	got = recordReadState(cnameState, &namecom.Record{ID: 42, DomainName: "example.com", Host: "www", Type: "CNAME", Answer: "bar.com."})
+	if got.Answer.ValueString() != "BAR.com" {
+		t.Errorf("CNAME answer = %q, want the configured %q", got.Answer.ValueString(), "BAR.com")
+	}
+}
+
+// TestRecordReadState_PreservesIPv6Representation covers the AAAA case the
+// earlier case/trailing-dot handling missed: the API returns the compressed
+// lower-case form of the configured address.
+func TestRecordReadState_PreservesIPv6Representation(t *testing.T) {
+	t.Parallel()
+
+	state := recordModel{
+		ID:         types.StringValue("42"),
+		DomainName: dnsStringOf[hostnameSemantics]("example.com"),
+		Host:       dnsStringOf[hostnameSemantics]("www"),
+		RecordType: dnsStringOf[recordTypeSemantics]("AAAA"),
+		Answer:     dnsStringOf[answerSemantics]("2001:DB8:0::1"),
+	}
+
+	record := &namecom.Record{ID: 42, DomainName: "example.com", Host: "www", Type: "AAAA", Answer: "2001:db8::1"}
+
+	got := recordReadState(state, record)
+
+	if got.Answer.ValueString() != "2001:DB8:0::1" {
+		t.Errorf("answer = %q, want the configured %q", got.Answer.ValueString(), "2001:DB8:0::1")
+	}
+}
@@ -3,13 +3,20 @@ package namedotcom
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/namedotcom/go/v4/namecom"
@@ -18,11 +25,11 @@ import (
 func fullDNSSECModel() dnssecModel {
 	return dnssecModel{
 		ID:         types.StringValue("example.com"),
-		DomainName: types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
 		KeyTag:     types.Int32Value(12345),
 		Algorithm:  types.Int32Value(8),
 		DigestType: types.Int32Value(2),
-		Digest:     types.StringValue("AABBCCDD"),
+		Digest:     digestValue{StringValue: types.StringValue("AABBCCDD")},
 	}
 }
 
@@ -91,11 +98,11 @@ func TestDNSSECRead_RemovesResourceOnNotFound(t *testing.T) {
 	req := resource.ReadRequest{
 		State: dnssecState(t, dnssecModel{
 			ID:         types.StringValue("example.com"),
-			DomainName: types.StringValue("example.com"),
+			DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
 			KeyTag:     types.Int32Value(12345),
 			Algorithm:  types.Int32Value(8),
 			DigestType: types.Int32Value(2),
-			Digest:     types.StringValue("AABBCCDD"),
+			Digest:     digestValue{StringValue: types.StringValue("AABBCCDD")},
 		}),
 	}
 	resp := resource.ReadResponse{State: dnssecState(t, dnssecModel{ID: types.StringValue("example.com")})}
@@ -148,6 +155,65 @@ func TestDNSSECCreate_SetsState(t *testing.T) {
 	}
 }
 
+// TestDNSSEC_SpacedDigest confirms a digest written with spaces reaches the
+// API without them, in the create body and in the read and delete paths.
+func TestDNSSEC_SpacedDigest(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	var created namecom.DNSSEC
+
+	var paths []string
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com/dnssec", func(writer http.ResponseWriter, request *http.Request) {
+		json.NewDecoder(request.Body).Decode(&created)
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domainName":"example.com","keyTag":12345,"algorithm":8,"digestType":2,"digest":"AABBCCDD"}`)
+	})
+	mux.HandleFunc("/v4/domains/example.com/dnssec/", func(writer http.ResponseWriter, request *http.Request) {
+		paths = append(paths, request.Method+" "+request.URL.Path)
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domainName":"example.com","keyTag":12345,"algorithm":8,"digestType":2,"digest":"AABBCCDD"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	res := &dnssecResource{client: namecom.Mock("u", "t", server.URL)}
+
+	model := fullDNSSECModel()
+	model.Digest = digestValue{StringValue: types.StringValue("AABB CCDD")}
+
+	createResp := resource.CreateResponse{State: tfsdk.State{Schema: dnssecSchema(t)}}
+
+	res.Create(context.Background(), resource.CreateRequest{Plan: dnssecPlan(t, model)}, &createResp)
+
+	readResp := resource.ReadResponse{State: createResp.State}
+
+	res.Read(context.Background(), resource.ReadRequest{State: createResp.State}, &readResp)
+
+	var deleteResp resource.DeleteResponse
+
+	res.Delete(context.Background(), resource.DeleteRequest{State: readResp.State}, &deleteResp)
+
+	for _, diags := range []diag.Diagnostics{createResp.Diagnostics, readResp.Diagnostics, deleteResp.Diagnostics} {
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+	}
+
+	if created.Digest != "AABBCCDD" {
+		t.Errorf("created digest = %q, want %q", created.Digest, "AABBCCDD")
+	}
+
+	want := []string{"GET /v4/domains/example.com/dnssec/AABBCCDD", "DELETE /v4/domains/example.com/dnssec/AABBCCDD"}
+	if !slices.Equal(paths, want) {
+		t.Errorf("requests = %q, want %q", paths, want)
+	}
+}
+
 // TestDNSSECImportState parses "DomainName_Digest" and seeds the lookup fields.
 func TestDNSSECImportState(t *testing.T) {
 	t.Parallel()
@@ -210,11 +276,11 @@ func TestDNSSECRead_PreservesConfiguredKeys(t *testing.T) {
 
 	model := dnssecModel{
 		ID:         types.StringValue("example.com"),
-		DomainName: types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
 		KeyTag:     types.Int32Value(12345),
 		Algorithm:  types.Int32Value(8),
 		DigestType: types.Int32Value(2),
-		Digest:     types.StringValue("aabbccdd"),
+		Digest:     digestValue{StringValue: types.StringValue("aabbccdd")},
 	}
 
 	resp := resource.ReadResponse{State: dnssecState(t, model)}
@@ -271,3 +337,204 @@ func dnssecPlan(t *testing.T, model dnssecModel) tfsdk.Plan {
 
 	return plan
 }
+
+// TestDSFromDNSKEYFunction_Run checks the function against the examples of RFC
+// 4509 (SHA-256) and RFC 6605 (SHA-384), given as a full multi-line record and
+// as bare RDATA.
+func TestDSFromDNSKEYFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		dnskey     string
+		domainName string
+		keyTag     int32
+		algorithm  int32
+		digestType int32
+		digest     string
+	}{
+		{
+			name: "RFC 4509",
+			dnskey: "dskey.example.com. 86400 IN DNSKEY 256 3 5 ( AQOeiiR0GOMYkDshWoSKz9Xz\n" +
+				"  fwJr1AYtsmx3TGkJaNXVbfi/2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvx\n" +
+				"  egXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9XzcnOf+EPbtG9DMBmADjFDc2w/r\n" +
+				"  ljwvFw== ) ; key id = 60485\n",
+			domainName: "DSKEY.example.com.",
+			keyTag:     60485,
+			algorithm:  5,
+			digestType: digestTypeSHA256,
+			digest:     "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A",
+		},
+		{
+			name: "RFC 6605",
+			dnskey: "257 3 14 xKYaNhWdGOfJ+nPrL8/arkwf2EY3MDJ+SErKivBVSum1w/egsXvSADtNJhyem5RCOpgQ6K8X1DRSEkrbYQ+OB+v8" +
+				"/uX45NBwY8rp65F6Glur8I/mlVNgF6W/qTI37m40",
+			domainName: "example.net",
+			keyTag:     10771,
+			algorithm:  14,
+			digestType: digestTypeSHA384,
+			digest:     "72D7B62976CE06438E9C0BF319013CF801F09ECC84B8D7E9495F27E305C6A9B0563A9B5F4D288405C3008A946DF983D6",
+		},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			fn := &dsFromDNSKEYFunction{}
+
+			req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+				types.StringValue(testCase.dnskey),
+				types.StringValue(testCase.domainName),
+			})}
+			resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: dsRecordAttrTypes}))}
+
+			fn.Run(context.Background(), req, &resp)
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %v", resp.Error)
+			}
+
+			list, ok := resp.Result.Value().(types.List)
+			if !ok {
+				t.Fatalf("result is %T, want types.List", resp.Result.Value())
+			}
+
+			var got []dsRecordModel
+
+			diags := list.ElementsAs(context.Background(), &got, false)
+			if diags.HasError() {
+				t.Fatalf("decoding result: %v", diags)
+			}
+
+			if len(got) != 2 || got[0].DigestType.ValueInt32() != digestTypeSHA256 || got[1].DigestType.ValueInt32() != digestTypeSHA384 {
+				t.Fatalf("got %+v, want a SHA-256 and a SHA-384 digest", got)
+			}
+
+			for _, record := range got {
+				if record.KeyTag.ValueInt32() != testCase.keyTag || record.Algorithm.ValueInt32() != testCase.algorithm {
+					t.Errorf("key_tag = %v, algorithm = %v, want %d and %d",
+						record.KeyTag, record.Algorithm, testCase.keyTag, testCase.algorithm)
+				}
+
+				if record.DigestType.ValueInt32() == testCase.digestType && record.Digest.ValueString() != testCase.digest {
+					t.Errorf("digest = %s, want %s", record.Digest, testCase.digest)
+				}
+			}
+
+			if got[0].DomainName.ValueString() != strings.ToLower(strings.TrimSuffix(testCase.domainName, ".")) {
+				t.Errorf("domain_name = %s, want it lower-cased without the trailing dot", got[0].DomainName)
+			}
+		})
+	}
+}
+
+// TestParseDNSKEY_Errors confirms that input which is not a zone key is
+// rejected rather than hashed.
+func TestParseDNSKEY_Errors(t *testing.T) {
+	t.Parallel()
+
+	for _, record := range []string{
+		"",
+		"256 3 13",
+		"256 2 13 AQID",
+		"256 3 13 not-base64!",
+		"0 3 13 AQID",
+		"256 3 13 AQID\n257 3 13 AQID",
+	} {
+		_, err := parseDNSKEY(record)
+		if err == nil {
+			t.Errorf("parseDNSKEY(%q) succeeded, want an error", record)
+		}
+	}
+}
+
+// TestDigestValidator pins the digest check: hex only, of a supported length,
+// with whitespace ignored.
+func TestDigestValidator(t *testing.T) {
+	t.Parallel()
+
+	sha256 := strings.Repeat("ab", 32)
+
+	cases := map[string]bool{
+		strings.Repeat("AB", 20):        true,
+		sha256:                          true,
+		sha256[:32] + " " + sha256[32:]: true,
+		strings.Repeat("ab", 48):        true,
+		"AABBCCDD":                      false,
+		strings.Repeat("zz", 32):        false,
+		strings.Repeat("ab", 32) + "ab": false,
+		"0x" + strings.Repeat("ab", 31): false,
+	}
+
+	for digest, valid := range cases {
+		var resp validator.StringResponse
+
+		digestValidator{}.ValidateString(context.Background(), validator.StringRequest{ConfigValue: types.StringValue(digest)}, &resp)
+
+		if resp.Diagnostics.HasError() == valid {
+			t.Errorf("digest %q: HasError = %v, want %v", digest, resp.Diagnostics.HasError(), !valid)
+		}
+	}
+}
+
+// TestDNSSECValidateConfig confirms a digest of the wrong length for its
+// digest type is caught at plan time, for both DNSSEC resources.
+func TestDNSSECValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	sha1 := strings.Repeat("AB", 20)
+	sha256 := strings.Repeat("AB", 32)
+
+	cases := []struct {
+		name       string
+		digestType types.Int32
+		digest     string
+		wantErr    bool
+	}{
+		{"SHA-256", types.Int32Value(2), sha256, false},
+		{"SHA-1 digest for SHA-256", types.Int32Value(2), sha1, true},
+		{"SHA-256 digest for SHA-384", types.Int32Value(4), sha256, true},
+		{"unknown digest type", types.Int32Unknown(), sha1, false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			model := fullDNSSECModel()
+			model.DigestType = testCase.digestType
+			model.Digest = digestValue{StringValue: types.StringValue(testCase.digest)}
+
+			var resp resource.ValidateConfigResponse
+
+			(&dnssecResource{}).ValidateConfig(
+				context.Background(),
+				resource.ValidateConfigRequest{Config: tfsdk.Config(dnssecState(t, model))},
+				&resp,
+			)
+
+			if resp.Diagnostics.HasError() != testCase.wantErr {
+				t.Errorf("dnssec: HasError = %v, want %v: %v", resp.Diagnostics.HasError(), testCase.wantErr, resp.Diagnostics)
+			}
+
+			var schemaResp resource.SchemaResponse
+
+			(&dnssecKeysResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+			key := dsKey(1, testCase.digest)
+			key.DigestType = testCase.digestType
+
+			keys := tfsdk.State{Schema: schemaResp.Schema}
+			keys.Set(context.Background(), dsKeysModel(false, dsKey(2, strings.Repeat("CD", 32)), key))
+
+			resp = resource.ValidateConfigResponse{}
+
+			(&dnssecKeysResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: tfsdk.Config(keys)}, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.wantErr {
+				t.Errorf("dnssec_keys: HasError = %v, want %v: %v", resp.Diagnostics.HasError(), testCase.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+//nolint:paralleltest // The DNSSEC keys and data source tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// dsKeysServer serves the DS records of example.com and records every change
+// made to them, in order.
+type dsKeysServer struct {
+	keys []*namecom.DNSSEC
+	ops  []string
+}
+
+func (s *dsKeysServer) client(t *testing.T) *namecom.NameCom {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com/dnssec", func(writer http.ResponseWriter, request *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+
+		if request.Method == http.MethodPost {
+			key := &namecom.DNSSEC{}
+			json.NewDecoder(request.Body).Decode(key)
+
+			s.keys = append(s.keys, key)
+			s.ops = append(s.ops, "create "+key.Digest)
+
+			json.NewEncoder(writer).Encode(key)
+
+			return
+		}
+
+		json.NewEncoder(writer).Encode(namecom.ListDNSSECsResponse{Dnssec: s.keys})
+	})
+	mux.HandleFunc("/v4/domains/example.com/dnssec/{digest}", func(writer http.ResponseWriter, request *http.Request) {
+		digest := request.PathValue("digest")
+
+		s.keys = slices.DeleteFunc(s.keys, func(key *namecom.DNSSEC) bool { return key.Digest == digest })
+		s.ops = append(s.ops, "delete "+digest)
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return namecom.Mock("u", "t", server.URL)
+}
+
+// dsKey returns a key of the set with the given tag and digest.
+func dsKey(keyTag int32, digest string) dsKeyModel {
+	return dsKeyModel{
+		KeyTag:     types.Int32Value(keyTag),
+		Algorithm:  types.Int32Value(13),
+		DigestType: types.Int32Value(2),
+		Digest:     digestValue{StringValue: types.StringValue(digest)},
+	}
+}
+
+// dsKeysModel returns the model of the keys of example.com.
+func dsKeysModel(removeUnmanaged bool, keys ...dsKeyModel) dnssecKeysModel {
+	return dnssecKeysModel{
+		ID:              types.StringValue("example.com"),
+		DomainName:      hostnameValue{StringValue: types.StringValue("example.com")},
+		Keys:            keys,
+		RemoveUnmanaged: types.BoolValue(removeUnmanaged),
+	}
+}
+
+// TestDNSSECKeysValidateConfig_DuplicateDigest confirms two keys whose digests
+// differ only in case or whitespace are rejected, since they are one DS record.
+func TestDNSSECKeysValidateConfig_DuplicateDigest(t *testing.T) {
+	digest := strings.Repeat("AB", 32)
+
+	cases := []struct {
+		name    string
+		second  dsKeyModel
+		wantErr bool
+	}{
+		{"distinct digests", dsKey(2, strings.Repeat("CD", 32)), false},
+		{"same digest, other key tag", dsKey(2, digest), true},
+		{"same digest in lower case with spaces", dsKey(2, strings.ToLower(digest[:32])+" "+strings.ToLower(digest[32:])), true},
+	}
+
+	var schemaResp resource.SchemaResponse
+
+	(&dnssecKeysResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			config := tfsdk.State{Schema: schemaResp.Schema}
+
+			diags := config.Set(context.Background(), dsKeysModel(false, dsKey(1, digest), testCase.second))
+			if diags.HasError() {
+				t.Fatalf("building config: %v", diags)
+			}
+
+			var resp resource.ValidateConfigResponse
+
+			(&dnssecKeysResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: tfsdk.Config(config)}, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.wantErr {
+				t.Errorf("HasError = %v, want %v: %v", resp.Diagnostics.HasError(), testCase.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// TestDNSSECKeysUpdate_Rollover confirms the new key is registered before the
+// retired one is deleted, and that unmanaged keys are only removed on request.
+func TestDNSSECKeysUpdate_Rollover(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	for _, removeUnmanaged := range []bool{false, true} {
+		server := &dsKeysServer{keys: []*namecom.DNSSEC{
+			{KeyTag: 1, Algorithm: 13, DigestType: 2, Digest: "OLD"},
+			{KeyTag: 9, Algorithm: 13, DigestType: 2, Digest: "OTHER"},
+		}}
+		res := &dnssecKeysResource{client: server.client(t)}
+
+		var schemaResp resource.SchemaResponse
+
+		res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		state.Set(context.Background(), dsKeysModel(removeUnmanaged, dsKey(1, "old")))
+
+		plan := tfsdk.Plan{Schema: schemaResp.Schema}
+		plan.Set(context.Background(), dsKeysModel(removeUnmanaged, dsKey(2, "NEW")))
+
+		resp := resource.UpdateResponse{State: state}
+
+		res.Update(context.Background(), resource.UpdateRequest{Plan: plan, State: state}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		want := []string{"create NEW", "delete OLD"}
+		if removeUnmanaged {
+			want = append(want, "delete OTHER")
+		}
+
+		slices.Sort(server.ops[1:])
+
+		if !slices.Equal(server.ops, want) {
+			t.Errorf("remove_unmanaged = %v: operations = %v, want %v", removeUnmanaged, server.ops, want)
+		}
+	}
+}
+
+// TestDNSSECKeysRead confirms only managed keys are kept in state, with their
+// configured digest, and that an import adopts every registered key.
+func TestDNSSECKeysRead(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &dsKeysServer{keys: []*namecom.DNSSEC{
+		{KeyTag: 1, Algorithm: 13, DigestType: 2, Digest: "AABB"},
+		{KeyTag: 9, Algorithm: 13, DigestType: 2, Digest: "CCDD"},
+	}}
+	res := &dnssecKeysResource{client: server.client(t)}
+
+	var schemaResp resource.SchemaResponse
+
+	res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	read := func(model dnssecKeysModel) []string {
+		t.Helper()
+
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		state.Set(context.Background(), model)
+
+		resp := resource.ReadResponse{State: state}
+
+		res.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		var got dnssecKeysModel
+
+		resp.State.Get(context.Background(), &got)
+
+		digests := make([]string, 0, len(got.Keys))
+		for _, key := range got.Keys {
+			digests = append(digests, key.Digest.ValueString())
+		}
+
+		slices.Sort(digests)
+
+		return digests
+	}
+
+	managed := read(dsKeysModel(false, dsKey(1, "aabb"), dsKey(3, "EEFF")))
+	if strings.Join(managed, ",") != "aabb" {
+		t.Errorf("managed keys = %v, want only the configured aabb", managed)
+	}
+
+	// An empty set is not an import: keys deleted outside Terraform must not
+	// make the next refresh adopt the unmanaged ones.
+	emptied := dsKeysModel(false)
+	emptied.Keys = []dsKeyModel{}
+
+	if gone := read(emptied); len(gone) != 0 {
+		t.Errorf("keys = %v after every managed key was deleted, want none", gone)
+	}
+
+	imported := read(dsKeysModel(false))
+	if strings.Join(imported, ",") != "AABB,CCDD" {
+		t.Errorf("imported keys = %v, want every registered key", imported)
+	}
+}
+
+// TestDNSSECDataSourceRead confirms the registered keys are listed in a stable
+// order, with import IDs the namedotcom_dnssec importer accepts.
+func TestDNSSECDataSourceRead(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &dsKeysServer{keys: []*namecom.DNSSEC{
+		{KeyTag: 9, Algorithm: 13, DigestType: 2, Digest: "CCDD"},
+		{KeyTag: 1, Algorithm: 13, DigestType: 4, Digest: "eeff"},
+		{KeyTag: 1, Algorithm: 13, DigestType: 2, Digest: "AABB"},
+	}}
+	src := &dnssecDataSource{client: server.client(t)}
+
+	var schemaResp datasource.SchemaResponse
+
+	src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(context.Background(), dnssecDataSourceModel{DomainName: types.StringValue("example.com")})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(state)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got dnssecDataSourceModel
+
+	resp.State.Get(context.Background(), &got)
+
+	digests := make([]string, 0, len(got.Keys))
+	for _, key := range got.Keys {
+		digests = append(digests, key.Digest.ValueString())
+	}
+
+	if strings.Join(digests, ",") != "AABB,eeff,CCDD" {
+		t.Errorf("digests = %v, want AABB,eeff,CCDD", digests)
+	}
+
+	domainName, digest, err := resourceDNSSECImporterParseID(got.Keys[1].ImportID.ValueString())
+	if err != nil || domainName != "example.com" || digest != "eeff" {
+		t.Errorf("import_id %s parses to %q, %q, %v", got.Keys[1].ImportID, domainName, digest, err)
+	}
+}
@@ -0,0 +1,127 @@
+package namedotcom
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// dnssecAlgorithms are the DNSSEC algorithms the IANA registry marks as usable
+// for zone signing, keyed by number.
+var dnssecAlgorithms = map[int32]string{
+	3:   "DSA",
+	5:   "RSASHA1",
+	6:   "DSA-NSEC3-SHA1",
+	7:   "RSASHA1-NSEC3-SHA1",
+	8:   "RSASHA256",
+	10:  "RSASHA512",
+	12:  "ECC-GOST",
+	13:  "ECDSAP256SHA256",
+	14:  "ECDSAP384SHA384",
+	15:  "ED25519",
+	16:  "ED448",
+	17:  "SM2SM3",
+	23:  "ECC-GOST12",
+	253: "PRIVATEDNS",
+	254: "PRIVATEOID",
+}
+
+// dsDigestTypes are the DS digest types supported, with the name and the
+// length in hex characters of their digests.
+var dsDigestTypes = map[int32]struct {
+	name   string
+	length int
+}{
+	digestTypeSHA1:   {"SHA-1", 40},
+	digestTypeSHA256: {"SHA-256", 64},
+	digestTypeSHA384: {"SHA-384", 96},
+}
+
+// algorithmValidator restricts an algorithm to the zone signing algorithms of
+// the IANA registry.
+func algorithmValidator() validator.Int32 {
+	return int32validator.OneOf(slices.Sorted(maps.Keys(dnssecAlgorithms))...)
+}
+
+// digestTypeValidator restricts a digest type to SHA-1, SHA-256 and SHA-384.
+func digestTypeValidator() validator.Int32 {
+	return int32validator.OneOf(slices.Sorted(maps.Keys(dsDigestTypes))...)
+}
+
+// digestValidator requires a digest to be hex of the length of one of the
+// supported digest types. Whitespace is ignored, as digestSemantics does, and
+// removed before the digest is sent (see compactDigest).
+type digestValidator struct{}
+
+func (v digestValidator) Description(_ context.Context) string {
+	return "value must be a hex digest of 40, 64 or 96 characters"
+}
+
+func (v digestValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v digestValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	digest := digestKey(req.ConfigValue.ValueString())
+
+	_, err := hex.DecodeString(digest)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid digest", "The digest must contain only hex digits.")
+
+		return
+	}
+
+	for _, digestType := range dsDigestTypes {
+		if len(digest) == digestType.length {
+			return
+		}
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		"Invalid digest",
+		fmt.Sprintf("The digest is %d hex characters; SHA-1, SHA-256 and SHA-384 digests are 40, 64 and 96.", len(digest)),
+	)
+}
+
+// compactDigest removes the whitespace a digest may be written with, such as
+// the spaces of a pasted DS record. The API stores and looks up digests
+// without it, so every digest sent to Name.com goes through compactDigest;
+// the letter case is kept as written.
+func compactDigest(digest string) string {
+	return strings.Join(strings.Fields(digest), "")
+}
+
+// checkDigestLength reports a digest whose length does not match its digest
+// type. Unknown values and unsupported types, which the attribute validators
+// already report, are skipped.
+func checkDigestLength(digestType types.Int32, digest types.String) error {
+	if digestType.IsNull() || digestType.IsUnknown() || digest.IsNull() || digest.IsUnknown() {
+		return nil
+	}
+
+	supported, ok := dsDigestTypes[digestType.ValueInt32()]
+	if !ok {
+		return nil
+	}
+
+	length := len(digestKey(digest.ValueString()))
+	if length != supported.length {
+		return errors.Newf("digest_type %d (%s) needs a %d-character digest, got %d characters",
+			digestType.ValueInt32(), supported.name, supported.length, length)
+	}
+
+	return nil
+}
@@ -0,0 +1,274 @@
+//nolint:paralleltest // The domain tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// domainServer answers CheckAvailability for example.com with the given
+// result, records the CreateDomain request, and returns created as the new
+// domain. Lock calls are counted.
+type domainServer struct {
+	availability string
+	created      string
+	request      namecom.CreateDomainRequest
+	locks        int
+}
+
+func (s *domainServer) client(t *testing.T) *namecom.NameCom {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains:checkAvailability", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"results":[%s]}`, s.availability)
+	})
+	mux.HandleFunc("/v4/domains", func(writer http.ResponseWriter, request *http.Request) {
+		err := json.NewDecoder(request.Body).Decode(&s.request)
+		if err != nil {
+			http.Error(writer, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"domain":%s,"order":42,"totalPaid":241}`, s.created)
+	})
+	mux.HandleFunc("/v4/domains/example.com:lock", func(writer http.ResponseWriter, _ *http.Request) {
+		s.locks++
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domainName":"example.com","locked":true}`)
+	})
+	mux.HandleFunc("/v4/domains/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		http.Error(writer, `{"message":"Domain not found"}`, http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return namecom.Mock("u", "t", server.URL)
+}
+
+const premiumAvailability = `{"domainName":"example.com","sld":"example","tld":"com","purchasable":true,` +
+	`"premium":true,"purchasePrice":120.5,"purchaseType":"registration","renewalPrice":120.5}`
+
+// plannedDomain is example.com planned for registration for two years.
+func plannedDomain(t *testing.T, maxPrice float64) domainModel {
+	t.Helper()
+
+	return domainModel{
+		ID:               types.StringUnknown(),
+		DomainName:       hostnameValue{StringValue: types.StringValue("example.com")},
+		Years:            types.Int32Value(2),
+		MaxPurchasePrice: types.Float64Value(maxPrice),
+		TLDRequirements:  types.MapNull(types.StringType),
+		Nameservers:      types.SetUnknown(hostnameType{}),
+		Contacts:         types.ObjectNull(domainSchemaAttrTypes(t, keyContacts)),
+		PrivacyEnabled:   types.BoolUnknown(),
+		AutorenewEnabled: types.BoolUnknown(),
+		Locked:           types.BoolValue(true),
+		PurchasePrice:    types.Float64Unknown(),
+		PurchaseType:     types.StringUnknown(),
+		Premium:          types.BoolUnknown(),
+		RenewalPrice:     types.Float64Unknown(),
+		OrderID:          types.Int32Unknown(),
+		CreateDate:       types.StringUnknown(),
+		ExpireDate:       types.StringUnknown(),
+	}
+}
+
+// domainSchemaAttrTypes returns the attribute types of an object attribute of
+// the domain schema.
+func domainSchemaAttrTypes(t *testing.T, key string) map[string]attr.Type {
+	t.Helper()
+
+	objectType, ok := domainSchema(t).Attributes[key].GetType().(types.ObjectType)
+	if !ok {
+		t.Fatalf("%s is not an object attribute", key)
+	}
+
+	return objectType.AttrTypes
+}
+
+// domainSchema returns the schema of the domain resource.
+func domainSchema(t *testing.T) schema.Schema {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+
+	(&domainResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	return schemaResp.Schema
+}
+
+// modifyDomainPlan runs ModifyPlan for a domain that is about to be created
+// and returns the resulting plan and diagnostics.
+func modifyDomainPlan(t *testing.T, res *domainResource, plan domainModel) (domainModel, diag.Diagnostics) {
+	t.Helper()
+
+	tfPlan := tfsdk.Plan{Schema: domainSchema(t)}
+	tfPlan.Set(context.Background(), &plan)
+
+	// A zero State.Raw is null: the domain does not exist yet.
+	req := resource.ModifyPlanRequest{Plan: tfPlan, State: tfsdk.State{Schema: tfPlan.Schema}}
+	resp := resource.ModifyPlanResponse{Plan: tfPlan}
+
+	res.ModifyPlan(context.Background(), req, &resp)
+
+	var got domainModel
+
+	resp.Plan.Get(context.Background(), &got)
+
+	return got, resp.Diagnostics
+}
+
+// TestDomainModifyPlan_PremiumPrice confirms the plan shows the premium price
+// for the whole registration period.
+func TestDomainModifyPlan_PremiumPrice(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &domainServer{availability: premiumAvailability}
+	got, diags := modifyDomainPlan(t, &domainResource{client: server.client(t)}, plannedDomain(t, 250))
+
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if got.PurchasePrice.ValueFloat64() != 241 || !got.Premium.ValueBool() || got.PurchaseType.ValueString() != "registration" {
+		t.Errorf("planned price = %v premium = %v type = %v; want 241, true, registration",
+			got.PurchasePrice, got.Premium, got.PurchaseType)
+	}
+}
+
+// TestDomainModifyPlan_Guard rejects a domain that costs more than
+// max_purchase_price, or cannot be bought at all.
+func TestDomainModifyPlan_Guard(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	cases := []struct {
+		name         string
+		availability string
+		wantPath     path.Path
+	}{
+		{"over the guard", premiumAvailability, path.Root(keyMaxPurchasePrice)},
+		{"taken", `{"domainName":"example.com","purchasable":false}`, path.Root(keyDomainName)},
+	}
+
+	for _, testCase := range cases {
+		server := &domainServer{availability: testCase.availability}
+		_, diags := modifyDomainPlan(t, &domainResource{client: server.client(t)}, plannedDomain(t, 200))
+
+		if !diags.HasError() {
+			t.Fatalf("%s: expected an error", testCase.name)
+		}
+
+		withPath, ok := diags[0].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(testCase.wantPath) {
+			t.Errorf("%s: diagnostic not attached to %s: %v", testCase.name, testCase.wantPath, diags[0])
+		}
+	}
+}
+
+// TestDomainCreate_Premium confirms the purchase passes the checked premium
+// price and purchase type, and locks the domain when the created domain is not
+// locked yet.
+func TestDomainCreate_Premium(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &domainServer{
+		availability: premiumAvailability,
+		created:      `{"domainName":"example.com","nameservers":["ns1.name.com"],"expireDate":"2028-10-18T00:00:00Z"}`,
+	}
+	res := &domainResource{client: server.client(t)}
+
+	plan, _ := modifyDomainPlan(t, res, plannedDomain(t, 250))
+
+	tfPlan := tfsdk.Plan{Schema: domainSchema(t)}
+	tfPlan.Set(context.Background(), &plan)
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: tfPlan.Schema}}
+
+	res.Create(context.Background(), resource.CreateRequest{Plan: tfPlan}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if server.request.PurchasePrice != 241 || server.request.PurchaseType != "registration" || server.request.Years != 2 {
+		t.Errorf("CreateDomain got price %v type %q years %d; want 241, registration, 2",
+			server.request.PurchasePrice, server.request.PurchaseType, server.request.Years)
+	}
+
+	if server.locks != 1 {
+		t.Errorf("LockDomain calls = %d, want 1", server.locks)
+	}
+
+	var got domainModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if !got.Locked.ValueBool() || got.OrderID.ValueInt32() != 42 || got.ExpireDate.ValueString() != "2028-10-18T00:00:00Z" {
+		t.Errorf("state locked = %v order = %v expires = %v", got.Locked, got.OrderID, got.ExpireDate)
+	}
+}
+
+// TestDomainCreate_PriceChanged refuses to buy when the price differs from the
+// one shown in the plan, even below the guard.
+func TestDomainCreate_PriceChanged(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &domainServer{availability: premiumAvailability}
+	res := &domainResource{client: server.client(t)}
+
+	plan := plannedDomain(t, 250)
+	plan.PurchasePrice = types.Float64Value(19.98)
+
+	tfPlan := tfsdk.Plan{Schema: domainSchema(t)}
+	tfPlan.Set(context.Background(), &plan)
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: tfPlan.Schema}}
+
+	res.Create(context.Background(), resource.CreateRequest{Plan: tfPlan}, &resp)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error for a changed price")
+	}
+
+	if server.request.Domain != nil {
+		t.Error("CreateDomain must not be called when the price changed")
+	}
+}
+
+// TestDomainDelete_OnlyWarns confirms destroy calls no API and only warns.
+func TestDomainDelete_OnlyWarns(t *testing.T) {
+	plan := plannedDomain(t, 250)
+	plan.ID = types.StringValue("example.com")
+	plan.ExpireDate = types.StringValue("2028-10-18T00:00:00Z")
+
+	state := tfsdk.State{Schema: domainSchema(t)}
+	state.Set(context.Background(), &plan)
+
+	resp := resource.DeleteResponse{State: state}
+
+	// A nil client would panic on any API call.
+	(&domainResource{}).Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("diagnostics = %v, want a single warning", resp.Diagnostics)
+	}
+}
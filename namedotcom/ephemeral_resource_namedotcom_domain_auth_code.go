@@ -0,0 +1,104 @@
+package namedotcom
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the ephemeral resource satisfies the required framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = (*domainAuthCodeEphemeralResource)(nil)
+	_ ephemeral.EphemeralResourceWithConfigure = (*domainAuthCodeEphemeralResource)(nil)
+)
+
+// domainAuthCodeEphemeralResource fetches the transfer authorization (EPP)
+// code of a domain. Being ephemeral, the code never reaches state or plan
+// files.
+type domainAuthCodeEphemeralResource struct {
+	client *namecom.NameCom
+}
+
+// domainAuthCodeModel maps the domain auth code schema to a Go struct.
+type domainAuthCodeModel struct {
+	DomainName types.String `tfsdk:"domain_name"`
+	AuthCode   types.String `tfsdk:"auth_code"`
+}
+
+// NewDomainAuthCodeEphemeralResource is the ephemeral resource factory
+// registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the ephemeral.EphemeralResource interface.
+func NewDomainAuthCodeEphemeralResource() ephemeral.EphemeralResource {
+	return &domainAuthCodeEphemeralResource{}
+}
+
+func (e *domainAuthCodeEphemeralResource) Metadata(_ context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_auth_code"
+}
+
+func (e *domainAuthCodeEphemeralResource) Schema(_ context.Context, _ ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Fetches the transfer authorization (EPP) code of a domain at apply time, without writing it to state or plan files.",
+		Attributes: map[string]schema.Attribute{
+			keyDomainName: schema.StringAttribute{
+				Required:    true,
+				Description: "DomainName is the domain whose auth code is fetched.",
+			},
+			keyAuthCode: schema.StringAttribute{
+				Computed:    true,
+				Sensitive:   true,
+				Description: "AuthCode is the authorization code another registrar needs to transfer the domain away from Name.com.",
+			},
+		},
+	}
+}
+
+func (e *domainAuthCodeEphemeralResource) Configure(_ context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	e.client = client
+}
+
+func (e *domainAuthCodeEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var config domainAuthCodeModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authCode, err := getAuthCodeAPI(ctx, e.client, config.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error fetching domain auth code", err.Error())
+
+		return
+	}
+
+	config.AuthCode = types.StringValue(authCode)
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &config)...)
+}
+
+// getAuthCodeAPI fetches the transfer authorization code of a domain via the
+// Name.com API.
+func getAuthCodeAPI(ctx context.Context, client *namecom.NameCom, domainName string) (string, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return "", errors.Wrap(err, "rate limiting error")
+	}
+
+	resp, err := client.GetAuthCodeForDomain(&namecom.AuthCodeRequest{DomainName: domainName})
+	if err != nil {
+		return "", errors.Wrap(err, "Error GetAuthCodeForDomain")
+	}
+
+	return resp.AuthCode, nil
+}
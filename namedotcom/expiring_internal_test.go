@@ -0,0 +1,122 @@
+//nolint:paralleltest // The expiring domains tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// TestParseExpireDate pins the expiry date layouts Name.com is known to use.
+func TestParseExpireDate(t *testing.T) {
+	want := time.Date(2026, time.November, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, value := range []string{
+		"2026-11-01T00:00:00Z",
+		"2026-11-01T00:00:00.000Z",
+		"2026-11-01T02:00:00+02:00",
+		"2026-11-01T00:00:00",
+		"2026-11-01 00:00:00",
+		"2026-11-01",
+		" 2026-11-01 ",
+	} {
+		got, err := parseExpireDate(value)
+		if err != nil {
+			t.Errorf("parseExpireDate(%q): %v", value, err)
+
+			continue
+		}
+
+		if !got.Equal(want) {
+			t.Errorf("parseExpireDate(%q) = %v, want %v", value, got, want)
+		}
+	}
+
+	_, err := parseExpireDate("01/11/2026")
+	if err == nil {
+		t.Error("parseExpireDate accepted a date in no known layout")
+	}
+}
+
+// TestExpiringDomainsRead confirms the window, ordering, risk flags and price
+// total against a fixed now.
+func TestExpiringDomainsRead(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domains":[`+
+			`{"domainName":"later.com","expireDate":"2027-06-01T00:00:00Z","autorenewEnabled":true,"locked":true,"renewalPrice":12.99},`+
+			`{"domainName":"soon.com","expireDate":"2026-11-10T00:00:00Z","autorenewEnabled":true,"locked":true,"renewalPrice":12.99},`+
+			`{"domainName":"sooner.net","expireDate":"2026-11-02","locked":true,"renewalPrice":14.99},`+
+			`{"domainName":"expired.org","expireDate":"2026-10-20T00:00:00Z","autorenewEnabled":true,"renewalPrice":10.5},`+
+			`{"domainName":"garbled.io","expireDate":"soon"}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	src := &expiringDomainsDataSource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp datasource.SchemaResponse
+
+	src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(context.Background(), expiringDomainsModel{
+		Days: types.Int32Value(30),
+		Now:  types.StringValue("2026-11-01T12:00:00Z"),
+	})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(state)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.WarningsCount() != 1 {
+		t.Errorf("warnings = %v, want one for garbled.io", resp.Diagnostics.Warnings())
+	}
+
+	var got expiringDomainsModel
+
+	resp.State.Get(context.Background(), &got)
+
+	want := []struct {
+		name     string
+		daysLeft int32
+		atRisk   bool
+	}{
+		{"expired.org", -13, true},
+		{"sooner.net", 0, true},
+		{"soon.com", 8, false},
+	}
+
+	if len(got.Domains) != len(want) {
+		t.Fatalf("domains = %+v, want %d", got.Domains, len(want))
+	}
+
+	for i, domain := range got.Domains {
+		if domain.DomainName.ValueString() != want[i].name ||
+			domain.DaysLeft.ValueInt32() != want[i].daysLeft ||
+			domain.AtRisk.ValueBool() != want[i].atRisk {
+			t.Errorf("domains[%d] = %s, %v days left, at risk %v; want %+v",
+				i, domain.DomainName, domain.DaysLeft, domain.AtRisk, want[i])
+		}
+	}
+
+	if got.RenewalPriceTotal.ValueFloat64() != 38.48 {
+		t.Errorf("renewal_price_total = %v, want 38.48", got.RenewalPriceTotal)
+	}
+}
@@ -12,21 +12,23 @@ var (
 	ResolveCredentials = resolveCredentials
 	BuildClient        = buildClient
 	ConfigureClient    = configureClient
+	NewProviderData    = newProviderData
 
 	// Resource helpers.
 	IsNotFoundError    = isNotFoundError
 	ExtractNameservers = extractNameservers
 
 	// API translation helpers.
-	CreateRecordAPI    = createRecordAPI
-	ReadRecordAPI      = readRecordAPI
-	UpdateRecordAPI    = updateRecordAPI
-	DeleteRecordAPI    = deleteRecordAPI
-	CreateDNSSECAPI    = createDNSSECAPI
-	ReadDNSSECAPI      = readDNSSECAPI
-	DeleteDNSSECAPI    = deleteDNSSECAPI
-	SetNameserversAPI  = setNameserversAPI
-	ReadNameserversAPI = readNameserversAPI
+	CreateRecordAPI   = createRecordAPI
+	ReadRecordAPI     = readRecordAPI
+	UpdateRecordAPI   = updateRecordAPI
+	DeleteRecordAPI   = deleteRecordAPI
+	ListRecordsAPI    = listRecordsAPI
+	CreateDNSSECAPI   = createDNSSECAPI
+	ReadDNSSECAPI     = readDNSSECAPI
+	DeleteDNSSECAPI   = deleteDNSSECAPI
+	SetNameserversAPI = setNameserversAPI
+	ReadDomainAPI     = readDomainAPI
 
 	// Rate limiter defaults.
 	DefaultPerSecondLimit = defaultPerSecondLimit
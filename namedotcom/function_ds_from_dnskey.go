@@ -0,0 +1,288 @@
+package namedotcom
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// DNSKEY and DS constants from RFC 4034 and the IANA DS digest type registry.
+const (
+	dnskeyProtocol    = 3
+	dnskeyFlagZoneKey = 0x0100
+	algorithmRSAMD5   = 1
+	digestTypeSHA1    = 1
+	digestTypeSHA256  = 2
+	digestTypeSHA384  = 4
+)
+
+// Ensure the function satisfies the framework interface.
+var _ function.Function = (*dsFromDNSKEYFunction)(nil)
+
+// dsFromDNSKEYFunction computes the DS records of a DNSKEY.
+type dsFromDNSKEYFunction struct{}
+
+// dsRecordModel is the object the function returns for each digest. It has the
+// same attribute names as the namedotcom_dnssec resource.
+type dsRecordModel struct {
+	DomainName types.String `tfsdk:"domain_name"`
+	KeyTag     types.Int32  `tfsdk:"key_tag"`
+	Algorithm  types.Int32  `tfsdk:"algorithm"`
+	DigestType types.Int32  `tfsdk:"digest_type"`
+	Digest     types.String `tfsdk:"digest"`
+}
+
+// dsRecordAttrTypes is the object type of dsRecordModel.
+var dsRecordAttrTypes = map[string]attr.Type{
+	keyDomainName: types.StringType,
+	keyKeyTag:     types.Int32Type,
+	keyAlgorithm:  types.Int32Type,
+	keyDigestType: types.Int32Type,
+	keyDigest:     types.StringType,
+}
+
+// dnskey is the RDATA of a DNSKEY record.
+type dnskey struct {
+	flags     uint16
+	protocol  uint8
+	algorithm uint8
+	publicKey []byte
+}
+
+// NewDSFromDNSKEYFunction is the function factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the function.Function interface.
+func NewDSFromDNSKEYFunction() function.Function {
+	return &dsFromDNSKEYFunction{}
+}
+
+func (f *dsFromDNSKEYFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "ds_from_dnskey"
+}
+
+func (f *dsFromDNSKEYFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Compute the DS records of a DNSKEY for namedotcom_dnssec",
+		//nolint:lll // The description is rendered verbatim in the registry docs.
+		Description: "Computes the key tag and the SHA-256 and SHA-384 digests of a DNSKEY, as dnssec-dsfromkey does. It returns a list of two objects, SHA-256 first, with the domain_name, key_tag, algorithm, digest_type and digest attributes of namedotcom_dnssec.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "dnskey",
+				Description: "The DNSKEY record in master-file syntax, such as a dnssec-keygen .key file, or only its RDATA.",
+			},
+			function.StringParameter{
+				Name:        "domain_name",
+				Description: "The owner name of the DNSKEY, which is the domain the DS records are registered for.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: types.ObjectType{AttrTypes: dsRecordAttrTypes},
+		},
+	}
+}
+
+func (f *dsFromDNSKEYFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var record, domainName string
+
+	resp.Error = req.Arguments.Get(ctx, &record, &domainName)
+	if resp.Error != nil {
+		return
+	}
+
+	key, err := parseDNSKEY(record)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+
+		return
+	}
+
+	owner, err := canonicalOwnerName(domainName)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, err.Error())
+
+		return
+	}
+
+	domainName = strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domainName)), ".")
+
+	models := make([]dsRecordModel, 0, 2)
+	for _, digestType := range []int32{digestTypeSHA256, digestTypeSHA384} {
+		models = append(models, dsRecordModel{
+			DomainName: types.StringValue(domainName),
+			KeyTag:     types.Int32Value(int32(key.keyTag())),
+			Algorithm:  types.Int32Value(int32(key.algorithm)),
+			DigestType: types.Int32Value(digestType),
+			Digest:     types.StringValue(key.digest(owner, digestType)),
+		})
+	}
+
+	resp.Error = resp.Result.Set(ctx, models)
+}
+
+// parseDNSKEY parses a DNSKEY record in master-file syntax. Everything up to
+// the DNSKEY type is ignored, so both a full record and bare RDATA are
+// accepted; parentheses and comments are handled as in a zone file.
+func parseDNSKEY(record string) (*dnskey, error) {
+	entries, err := splitZoneEntries(record)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entries) != 1 {
+		return nil, errors.Newf("expected one DNSKEY record, got %d entries", len(entries))
+	}
+
+	fields := make([]string, 0, len(entries[0].tokens))
+	for _, token := range entries[0].tokens {
+		fields = append(fields, token.text)
+	}
+
+	for i, field := range fields {
+		if strings.EqualFold(field, "DNSKEY") {
+			fields = fields[i+1:]
+
+			break
+		}
+	}
+
+	const minFields = 4
+	if len(fields) < minFields {
+		return nil, errors.New("expected flags, protocol, algorithm and public key")
+	}
+
+	flags, err := strconv.ParseUint(fields[0], 10, 16)
+	if err != nil {
+		return nil, errors.Newf("invalid flags %q", fields[0])
+	}
+
+	protocol, err := strconv.ParseUint(fields[1], 10, 8)
+	if err != nil || protocol != dnskeyProtocol {
+		return nil, errors.Newf("invalid protocol %q, DNSKEY records always use 3", fields[1])
+	}
+
+	algorithm, err := strconv.ParseUint(fields[2], 10, 8)
+	if err != nil || algorithm == 0 {
+		return nil, errors.Newf("invalid algorithm %q", fields[2])
+	}
+
+	publicKey, err := base64.StdEncoding.DecodeString(strings.Join(fields[3:], ""))
+	if err != nil || len(publicKey) == 0 {
+		return nil, errors.New("the public key is not valid base64")
+	}
+
+	if flags&dnskeyFlagZoneKey == 0 {
+		return nil, errors.Newf("flags %d do not mark a zone key, so the key cannot sign the zone", flags)
+	}
+
+	return &dnskey{
+		flags:     uint16(flags),    //nolint:gosec // ParseUint bounds the value to 16 bits.
+		protocol:  uint8(protocol),  //nolint:gosec // ParseUint bounds the value to 8 bits.
+		algorithm: uint8(algorithm), //nolint:gosec // ParseUint bounds the value to 8 bits.
+		publicKey: publicKey,
+	}, nil
+}
+
+// canonicalOwnerName returns a domain name in canonical wire format (RFC 4034
+// section 6.2): lower-case labels, each preceded by its length, ending with the
+// root label.
+func canonicalOwnerName(domainName string) ([]byte, error) {
+	name := strings.TrimSuffix(strings.ToLower(strings.TrimSpace(domainName)), ".")
+	if name == "" {
+		return nil, errors.New("domain name must not be empty")
+	}
+
+	var wire []byte
+
+	for label := range strings.SplitSeq(name, ".") {
+		if label == "" || len(label) > dnsLabelMaxLength {
+			return nil, errors.Newf("%q has an empty label or one longer than %d characters", domainName, dnsLabelMaxLength)
+		}
+
+		for _, char := range label {
+			if char > '~' || char < '!' {
+				return nil, errors.Newf("%q must be an ASCII domain name; give internationalized names in punycode", domainName)
+			}
+		}
+
+		wire = append(wire, byte(len(label))) //nolint:gosec // Labels are at most 63 octets.
+		wire = append(wire, label...)
+	}
+
+	wire = append(wire, 0)
+
+	if len(wire) > dnsNameMaxWireLength {
+		return nil, errors.Newf("%q is longer than %d octets", domainName, dnsNameMaxWireLength)
+	}
+
+	return wire, nil
+}
+
+// rdata returns the DNSKEY RDATA in wire format.
+func (k *dnskey) rdata() []byte {
+	wire := binary.BigEndian.AppendUint16(nil, k.flags)
+	wire = append(wire, k.protocol, k.algorithm)
+
+	return append(wire, k.publicKey...)
+}
+
+// keyTag computes the key tag of RFC 4034 appendix B.
+func (k *dnskey) keyTag() uint16 {
+	// RSA/MD5 keys use the low bits of the modulus instead of a checksum.
+	if k.algorithm == algorithmRSAMD5 {
+		const modulusTail = 3
+		if len(k.publicKey) < modulusTail {
+			return 0
+		}
+
+		return binary.BigEndian.Uint16(k.publicKey[len(k.publicKey)-modulusTail:])
+	}
+
+	// The checksum adds the RDATA as big-endian 16-bit words, padding an odd
+	// length with a zero octet, and folds the carry back in once.
+	const carryShift = 16
+
+	rdata := k.rdata()
+	if len(rdata)%2 == 1 {
+		rdata = append(rdata, 0)
+	}
+
+	var sum uint32
+
+	for word := range slices.Chunk(rdata, 2) {
+		sum += uint32(binary.BigEndian.Uint16(word))
+	}
+
+	sum += sum >> carryShift
+
+	return uint16(sum) //nolint:gosec // The key tag is the low 16 bits of the checksum.
+}
+
+// digest returns the upper-case hex DS digest of the key for an owner name in
+// canonical wire format (RFC 4034 section 5.1.4).
+func (k *dnskey) digest(owner []byte, digestType int32) string {
+	data := slices.Concat(owner, k.rdata())
+
+	var sum []byte
+
+	switch digestType {
+	case digestTypeSHA384:
+		hash := sha512.Sum384(data)
+		sum = hash[:]
+	default:
+		hash := sha256.Sum256(data)
+		sum = hash[:]
+	}
+
+	return strings.ToUpper(hex.EncodeToString(sum))
+}
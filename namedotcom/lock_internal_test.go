@@ -0,0 +1,150 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// lockState builds a tfsdk.State carrying the domain lock schema and model.
+func lockState(t *testing.T, model domainLockModel) tfsdk.State {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+
+	(&domainLockResource{}).Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+
+	diags := state.Set(context.Background(), &model)
+	if diags.HasError() {
+		t.Fatalf("building domain lock state: %v", diags)
+	}
+
+	return state
+}
+
+// TestDomainLockValidateConfig_PreventUnlock pins the safeguard: unlocking
+// fails unless prevent_unlock is explicitly false.
+func TestDomainLockValidateConfig_PreventUnlock(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		locked        types.Bool
+		preventUnlock types.Bool
+		wantErr       bool
+	}{
+		{"lock", types.BoolValue(true), types.BoolNull(), false},
+		{"default lock", types.BoolNull(), types.BoolNull(), false},
+		{"unlock with the default safeguard", types.BoolValue(false), types.BoolNull(), true},
+		{"unlock with the safeguard on", types.BoolValue(false), types.BoolValue(true), true},
+		{"unlock with the safeguard off", types.BoolValue(false), types.BoolValue(false), false},
+		{"unlock with an unknown safeguard", types.BoolValue(false), types.BoolUnknown(), false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config(lockState(t, domainLockModel{
+				ID:            types.StringNull(),
+				DomainName:    hostnameValue{StringValue: types.StringValue("example.com")},
+				Locked:        testCase.locked,
+				PreventUnlock: testCase.preventUnlock,
+			}))
+
+			var resp resource.ValidateConfigResponse
+
+			(&domainLockResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.wantErr {
+				t.Errorf("HasError = %v, want %v: %v", resp.Diagnostics.HasError(), testCase.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// TestDomainLockModifyPlan_PreventUnlock covers values that were unknown during
+// validation: once the plan knows them, unlocking with the safeguard on fails.
+func TestDomainLockModifyPlan_PreventUnlock(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		locked        bool
+		preventUnlock bool
+		wantErr       bool
+	}{
+		{"lock", true, true, false},
+		{"unlock with the safeguard on", false, true, true},
+		{"unlock with the safeguard off", false, false, false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			plan := lockState(t, domainLockModel{
+				ID:            types.StringValue("example.com"),
+				DomainName:    hostnameValue{StringValue: types.StringValue("example.com")},
+				Locked:        types.BoolValue(testCase.locked),
+				PreventUnlock: types.BoolValue(testCase.preventUnlock),
+			})
+			resp := resource.ModifyPlanResponse{Plan: tfsdk.Plan(plan)}
+
+			(&domainLockResource{}).ModifyPlan(context.Background(), resource.ModifyPlanRequest{Plan: tfsdk.Plan(plan)}, &resp)
+
+			if resp.Diagnostics.HasError() != testCase.wantErr {
+				t.Errorf("HasError = %v, want %v: %v", resp.Diagnostics.HasError(), testCase.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// TestDomainLockRead_Drift confirms an unlock made outside Terraform is
+// reported, so the next apply locks the domain again.
+//
+//nolint:paralleltest // Exercises the global rate limiter.
+func TestDomainLockRead_Drift(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domainName":"example.com","locked":false}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	res := &domainLockResource{client: namecom.Mock("u", "t", server.URL)}
+	state := lockState(t, domainLockModel{
+		ID:            types.StringValue("example.com"),
+		DomainName:    hostnameValue{StringValue: types.StringValue("example.com")},
+		Locked:        types.BoolValue(true),
+		PreventUnlock: types.BoolValue(true),
+	})
+	resp := resource.ReadResponse{State: state}
+
+	res.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got domainLockModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.Locked.ValueBool() {
+		t.Error("locked = true, want the drifted false")
+	}
+}
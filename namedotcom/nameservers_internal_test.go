@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -61,6 +63,10 @@ func TestUpgradeNameserversV0ToV1(t *testing.T) {
 		t.Errorf("id = %q, want %q", upgraded.ID.ValueString(), "example.com")
 	}
 
+	if upgraded.OnDestroy.ValueString() != onDestroyRetain {
+		t.Errorf("on_destroy = %q, want %q", upgraded.OnDestroy.ValueString(), onDestroyRetain)
+	}
+
 	var nameservers []string
 
 	upgraded.Nameservers.ElementsAs(ctx, &nameservers, false)
@@ -97,9 +103,10 @@ func TestDomainNameServersCreate_SetsState(t *testing.T) {
 
 	req := resource.CreateRequest{
 		Plan: nameserversV1Plan(t, nameserversModel{
-			ID:          types.StringNull(),
-			DomainName:  types.StringValue("example.com"),
-			Nameservers: nameservers,
+			ID:                   types.StringNull(),
+			DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+			Nameservers:          nameservers,
+			EffectiveNameservers: types.SetNull(hostnameType{}),
 		}),
 	}
 	resp := resource.CreateResponse{State: tfsdk.State{Schema: nameserversV1Schema(t)}}
@@ -200,16 +207,22 @@ func nameserversMock(t *testing.T, setStatus, getStatus int, getBody string) *na
 
 const nameserversDomainBody = `{"domainName":"example.com","nameservers":["ns1.example.com","ns2.example.com"]}`
 
+// TestDomainNameServersRead_Success confirms a refresh reports the nameservers
+// set on the domain, so a change made outside Terraform shows up as drift.
+//
 //nolint:paralleltest // exercises the global rate limiter
 func TestDomainNameServersRead_Success(t *testing.T) {
 	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
 
 	res := &domainNameServersResource{client: nameserversMock(t, http.StatusOK, http.StatusOK, nameserversDomainBody)}
 
+	managed, _ := types.SetValueFrom(context.Background(), hostnameType{}, []string{"ns1.example.net", "ns2.example.net"})
+
 	req := resource.ReadRequest{State: nameserversV1State(t, nameserversModel{
-		ID:          types.StringValue("example.com"),
-		DomainName:  types.StringValue("example.com"),
-		Nameservers: types.SetNull(types.StringType),
+		ID:                   types.StringValue("example.com"),
+		DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+		Nameservers:          managed,
+		EffectiveNameservers: managed,
 	})}
 	resp := resource.ReadResponse{State: tfsdk.State{Schema: nameserversV1Schema(t)}}
 
@@ -219,17 +232,159 @@ func TestDomainNameServersRead_Success(t *testing.T) {
 		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
 	}
 
-	var got nameserversModel
+	got := readNameserversState(t, resp.State)
 
-	resp.State.Get(context.Background(), &got)
+	for name, set := range map[string]types.Set{"nameservers": got.Nameservers, "effective_nameservers": got.EffectiveNameservers} {
+		var nsList []string
 
-	var nsList []string
+		set.ElementsAs(context.Background(), &nsList, false)
 
-	got.Nameservers.ElementsAs(context.Background(), &nsList, false)
+		if !slices.Equal(nsList, []string{"ns1.example.com", "ns2.example.com"}) {
+			t.Errorf("%s = %v, want the nameservers reported by the API", name, nsList)
+		}
+	}
+}
 
-	if len(nsList) != 2 {
-		t.Fatalf("expected 2 nameservers, got %d", len(nsList))
+// TestDomainNameServersRead_AccountDefaults confirms that a domain using the
+// account defaults keeps nameservers null on refresh, so a change to the
+// defaults is not drift, reports them in effective_nameservers and warns when
+// they changed.
+//
+//nolint:paralleltest // exercises the global rate limiter
+func TestDomainNameServersRead_AccountDefaults(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	tests := []struct {
+		name     string
+		previous []string
+		wantWarn bool
+	}{
+		{name: "changed", previous: []string{"ns1.name.com"}, wantWarn: true},
+		{name: "unchanged", previous: []string{"ns1.example.com", "ns2.example.com"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := &domainNameServersResource{client: nameserversMock(t, http.StatusOK, http.StatusOK, nameserversDomainBody)}
+
+			previous, _ := types.SetValueFrom(context.Background(), hostnameType{}, tt.previous)
+
+			req := resource.ReadRequest{State: nameserversV1State(t, nameserversModel{
+				ID:                   types.StringValue("example.com"),
+				DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+				Nameservers:          types.SetNull(hostnameType{}),
+				UseAccountDefaults:   types.BoolValue(true),
+				EffectiveNameservers: previous,
+			})}
+			resp := resource.ReadResponse{State: tfsdk.State{Schema: nameserversV1Schema(t)}}
+
+			res.Read(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			if got := resp.Diagnostics.WarningsCount() > 0; got != tt.wantWarn {
+				t.Errorf("warning = %v, want %v: %v", got, tt.wantWarn, resp.Diagnostics)
+			}
+
+			got := readNameserversState(t, resp.State)
+
+			if !got.Nameservers.IsNull() {
+				t.Errorf("nameservers = %v, want null", got.Nameservers)
+			}
+
+			var effective []string
+
+			got.EffectiveNameservers.ElementsAs(context.Background(), &effective, false)
+
+			if !slices.Equal(effective, []string{"ns1.example.com", "ns2.example.com"}) {
+				t.Errorf("effective_nameservers = %v, want the current account defaults", effective)
+			}
+		})
+	}
+}
+
+// TestDomainNameServersModifyPlan covers how effective_nameservers is planned:
+// from the configured nameservers, from state while the account defaults stay
+// in use, and unknown when switching to the defaults.
+func TestDomainNameServersModifyPlan(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	custom, _ := types.SetValueFrom(ctx, hostnameType{}, []string{"ns1.example.net", "ns2.example.net"})
+	defaults, _ := types.SetValueFrom(ctx, hostnameType{}, []string{"ns1.name.com", "ns2.name.com"})
+	unknown := types.SetUnknown(hostnameType{})
+	null := types.SetNull(hostnameType{})
+
+	testCases := []struct {
+		name       string
+		state      types.Set
+		stateEff   types.Set
+		planned    types.Set
+		wantEff    types.Set
+		noPriorRes bool
+	}{
+		{"configured nameservers", defaults, defaults, custom, custom, false},
+		{"create with defaults", null, null, null, unknown, true},
+		{"keep defaults", null, defaults, null, defaults, false},
+		{"switch to defaults", custom, custom, null, unknown, false},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			planModel := nameserversModel{
+				ID:                   types.StringUnknown(),
+				DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+				Nameservers:          testCase.planned,
+				EffectiveNameservers: unknown,
+			}
+			plan := nameserversV1Plan(t, planModel)
+
+			state := tfsdk.State{Schema: plan.Schema}
+			if !testCase.noPriorRes {
+				state = nameserversV1State(t, nameserversModel{
+					ID:                   types.StringValue("example.com"),
+					DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+					Nameservers:          testCase.state,
+					UseAccountDefaults:   types.BoolValue(false),
+					EffectiveNameservers: testCase.stateEff,
+				})
+			}
+
+			resp := resource.ModifyPlanResponse{Plan: plan}
+
+			(&domainNameServersResource{}).ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got nameserversModel
+
+			resp.Plan.Get(ctx, &got)
+
+			if !got.EffectiveNameservers.Equal(testCase.wantEff) {
+				t.Errorf("effective_nameservers = %v, want %v", got.EffectiveNameservers, testCase.wantEff)
+			}
+		})
+	}
+}
+
+// readNameserversState decodes a nameservers state, failing the test on error.
+func readNameserversState(t *testing.T, state tfsdk.State) nameserversModel {
+	t.Helper()
+
+	var got nameserversModel
+
+	diags := state.Get(context.Background(), &got)
+	if diags.HasError() {
+		t.Fatalf("reading nameservers state: %v", diags)
 	}
+
+	return got
 }
 
 //nolint:paralleltest // exercises the global rate limiter
@@ -239,14 +394,16 @@ func TestDomainNameServersRead_RemovesResourceOnNotFound(t *testing.T) {
 	res := &domainNameServersResource{client: nameserversMock(t, http.StatusOK, http.StatusNotFound, "")}
 
 	req := resource.ReadRequest{State: nameserversV1State(t, nameserversModel{
-		ID:          types.StringValue("example.com"),
-		DomainName:  types.StringValue("example.com"),
-		Nameservers: types.SetNull(types.StringType),
+		ID:                   types.StringValue("example.com"),
+		DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+		Nameservers:          types.SetNull(types.StringType),
+		EffectiveNameservers: types.SetNull(hostnameType{}),
 	})}
 	resp := resource.ReadResponse{State: nameserversV1State(t, nameserversModel{
-		ID:          types.StringValue("example.com"),
-		DomainName:  types.StringValue("example.com"),
-		Nameservers: types.SetNull(types.StringType),
+		ID:                   types.StringValue("example.com"),
+		DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+		Nameservers:          types.SetNull(types.StringType),
+		EffectiveNameservers: types.SetNull(hostnameType{}),
 	})}
 
 	res.Read(context.Background(), req, &resp)
@@ -272,11 +429,20 @@ func TestDomainNameServersUpdate_RemovesResourceOnNotFound(t *testing.T) {
 
 	nameservers, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"ns1.example.com"})
 
-	req := resource.UpdateRequest{Plan: nameserversV1Plan(t, nameserversModel{
-		ID:          types.StringValue("example.com"),
-		DomainName:  types.StringValue("example.com"),
-		Nameservers: nameservers,
-	})}
+	req := resource.UpdateRequest{
+		Plan: nameserversV1Plan(t, nameserversModel{
+			ID:                   types.StringValue("example.com"),
+			DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+			Nameservers:          nameservers,
+			EffectiveNameservers: types.SetNull(hostnameType{}),
+		}),
+		State: nameserversV1State(t, nameserversModel{
+			ID:                   types.StringValue("example.com"),
+			DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+			Nameservers:          types.SetNull(hostnameType{}),
+			EffectiveNameservers: types.SetNull(hostnameType{}),
+		}),
+	}
 	resp := resource.UpdateResponse{State: tfsdk.State{Schema: nameserversV1Schema(t)}}
 
 	res.Update(context.Background(), req, &resp)
@@ -290,20 +456,127 @@ func TestDomainNameServersUpdate_RemovesResourceOnNotFound(t *testing.T) {
 	}
 }
 
+// TestDomainNameServersUpdate_LegacyState covers state written before
+// nameservers stopped being computed, for a configuration that leaves
+// nameservers out: the plan moves the list to effective_nameservers and the
+// apply makes no SetNameservers call, so nameservers changed outside Terraform
+// are not reset.
+//
+//nolint:paralleltest // exercises the global rate limiter
+func TestDomainNameServersUpdate_LegacyState(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	ctx := context.Background()
+
+	var calls int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com:setNameservers", func(writer http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{}`)
+	})
+	mux.HandleFunc("/v4/domains/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, nameserversDomainBody)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	res := &domainNameServersResource{client: namecom.Mock("u", "t", server.URL)}
+
+	current, _ := types.SetValueFrom(ctx, hostnameType{}, []string{"ns1.example.com", "ns2.example.com"})
+
+	// As refreshed from an earlier version: use_account_defaults and
+	// on_destroy did not exist yet.
+	state := nameserversV1State(t, nameserversModel{
+		ID:                   types.StringValue("example.com"),
+		DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+		Nameservers:          current,
+		EffectiveNameservers: current,
+	})
+	plan := nameserversV1Plan(t, nameserversModel{
+		ID:                   types.StringValue("example.com"),
+		DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+		Nameservers:          types.SetNull(hostnameType{}),
+		UseAccountDefaults:   types.BoolValue(false),
+		EffectiveNameservers: types.SetUnknown(hostnameType{}),
+		OnDestroy:            types.StringValue(onDestroyRetain),
+		AllowSingle:          types.BoolValue(false),
+	})
+
+	planResp := resource.ModifyPlanResponse{Plan: plan}
+
+	res.ModifyPlan(ctx, resource.ModifyPlanRequest{Plan: plan, State: state}, &planResp)
+
+	if planResp.Diagnostics.HasError() || planResp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("diagnostics = %v, want a single warning", planResp.Diagnostics)
+	}
+
+	var planned nameserversModel
+
+	planResp.Plan.Get(ctx, &planned)
+
+	if !planned.EffectiveNameservers.Equal(current) {
+		t.Errorf("planned effective_nameservers = %v, want %v", planned.EffectiveNameservers, current)
+	}
+
+	resp := resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema}}
+
+	res.Update(ctx, resource.UpdateRequest{Plan: planResp.Plan, State: state}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if calls != 0 {
+		t.Errorf("SetNameservers calls = %d, want 0", calls)
+	}
+
+	got := readNameserversState(t, resp.State)
+
+	if !got.Nameservers.IsNull() || !got.EffectiveNameservers.Equal(current) {
+		t.Errorf("state nameservers = %v, effective_nameservers = %v, want null and %v",
+			got.Nameservers, got.EffectiveNameservers, current)
+	}
+
+	// Asking for the account defaults explicitly does reset the domain.
+	explicit := nameserversV1Plan(t, nameserversModel{
+		ID:                   types.StringValue("example.com"),
+		DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+		Nameservers:          types.SetNull(hostnameType{}),
+		UseAccountDefaults:   types.BoolValue(true),
+		EffectiveNameservers: types.SetUnknown(hostnameType{}),
+	})
+
+	res.Update(ctx, resource.UpdateRequest{Plan: explicit, State: state}, &resource.UpdateResponse{State: tfsdk.State{Schema: plan.Schema}})
+
+	if calls != 1 {
+		t.Errorf("SetNameservers calls with use_account_defaults = %d, want 1", calls)
+	}
+}
+
 // TestDomainNameServersDelete_ResetsNameservers asserts Delete sends an empty
-// nameserver list (resetting the domain to account defaults).
+// nameserver list (resetting the domain to account defaults) when on_destroy
+// is reset.
 //
 //nolint:paralleltest // exercises the global rate limiter
 func TestDomainNameServersDelete_ResetsNameservers(t *testing.T) {
 	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
 
-	var sentNameservers []string
+	var (
+		calls           int
+		sentNameservers []string
+	)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/v4/domains/example.com:setNameservers", func(writer http.ResponseWriter, request *http.Request) {
 		var payload namecom.SetNameserversRequest
 
 		_ = json.NewDecoder(request.Body).Decode(&payload)
+		calls++
 		sentNameservers = payload.Nameservers
 
 		writer.Header().Set("Content-Type", "application/json")
@@ -318,18 +591,191 @@ func TestDomainNameServersDelete_ResetsNameservers(t *testing.T) {
 	nameservers, _ := types.SetValueFrom(context.Background(), types.StringType, []string{"ns1.example.com"})
 
 	req := resource.DeleteRequest{State: nameserversV1State(t, nameserversModel{
-		ID:          types.StringValue("example.com"),
-		DomainName:  types.StringValue("example.com"),
-		Nameservers: nameservers,
+		ID:                   types.StringValue("example.com"),
+		DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+		Nameservers:          nameservers,
+		EffectiveNameservers: types.SetNull(hostnameType{}),
+		OnDestroy:            types.StringValue(onDestroyReset),
 	})}
 
 	res.Delete(context.Background(), req, &resource.DeleteResponse{})
 
+	if calls != 1 {
+		t.Fatalf("SetNameservers calls = %d, want 1", calls)
+	}
+
 	if len(sentNameservers) != 0 {
 		t.Errorf("expected an empty nameserver list on delete, got %v", sentNameservers)
 	}
 }
 
+// TestDomainNameServersDelete_Retain asserts Delete leaves the nameservers
+// alone when on_destroy is retain, and when state predates on_destroy.
+//
+//nolint:paralleltest // exercises the global rate limiter
+func TestDomainNameServersDelete_Retain(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	var calls int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com:setNameservers", func(writer http.ResponseWriter, _ *http.Request) {
+		calls++
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	res := &domainNameServersResource{client: namecom.Mock("u", "t", server.URL)}
+
+	for _, onDestroy := range []types.String{types.StringValue(onDestroyRetain), types.StringNull()} {
+		req := resource.DeleteRequest{State: nameserversV1State(t, nameserversModel{
+			ID:                   types.StringValue("example.com"),
+			DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+			Nameservers:          types.SetNull(hostnameType{}),
+			EffectiveNameservers: types.SetNull(hostnameType{}),
+			OnDestroy:            onDestroy,
+		})}
+		resp := resource.DeleteResponse{}
+
+		res.Delete(context.Background(), req, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("on_destroy %s: unexpected diagnostics: %v", onDestroy, resp.Diagnostics)
+		}
+	}
+
+	if calls != 0 {
+		t.Errorf("SetNameservers calls = %d, want 0", calls)
+	}
+}
+
+// TestDomainNameServersValidateConfig covers the checks made at plan time:
+// duplicates after canonicalization and the two-nameserver minimum.
+func TestDomainNameServersValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		nameservers []string
+		allowSingle bool
+		wantErr     string
+	}{
+		{"two", []string{"ns1.example.com", "ns2.example.com"}, false, ""},
+		{"single", []string{"ns1.example.com"}, false, "Too few nameservers"},
+		{"single allowed", []string{"ns1.example.com"}, true, ""},
+		{"duplicate", []string{"ns1.example.com", "NS1.Example.com.", "ns2.example.com"}, false, "Duplicate nameserver"},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			nameservers, _ := types.SetValueFrom(context.Background(), hostnameType{}, testCase.nameservers)
+			config := tfsdk.Config(nameserversV1State(t, nameserversModel{
+				ID:                   types.StringNull(),
+				DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+				Nameservers:          nameservers,
+				EffectiveNameservers: types.SetNull(hostnameType{}),
+				AllowSingle:          types.BoolValue(testCase.allowSingle),
+			}))
+
+			var resp resource.ValidateConfigResponse
+
+			(&domainNameServersResource{}).ValidateConfig(context.Background(), resource.ValidateConfigRequest{Config: config}, &resp)
+
+			if testCase.wantErr == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+
+				return
+			}
+
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != testCase.wantErr {
+				t.Errorf("diagnostics = %v, want %q", resp.Diagnostics, testCase.wantErr)
+			}
+		})
+	}
+}
+
+// TestDomainNameServersValidateConfig_AccountDefaults covers the checks on
+// use_account_defaults: it conflicts with nameservers, and leaving both out
+// warns that the account defaults are used implicitly.
+func TestDomainNameServersValidateConfig_AccountDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	nameservers, _ := types.SetValueFrom(ctx, hostnameType{}, []string{"ns1.example.com", "ns2.example.com"})
+	null := types.SetNull(hostnameType{})
+
+	testCases := []struct {
+		name        string
+		nameservers types.Set
+		useDefaults types.Bool
+		wantErrors  int
+		wantWarns   int
+	}{
+		{"explicit defaults", null, types.BoolValue(true), 0, 0},
+		{"implicit defaults", null, types.BoolNull(), 0, 1},
+		{"nameservers", nameservers, types.BoolNull(), 0, 0},
+		{"conflict", nameservers, types.BoolValue(true), 1, 0},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config(nameserversV1State(t, nameserversModel{
+				ID:                   types.StringNull(),
+				DomainName:           hostnameValue{StringValue: types.StringValue("example.com")},
+				Nameservers:          testCase.nameservers,
+				UseAccountDefaults:   testCase.useDefaults,
+				EffectiveNameservers: null,
+			}))
+
+			var resp resource.ValidateConfigResponse
+
+			(&domainNameServersResource{}).ValidateConfig(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+
+			if resp.Diagnostics.ErrorsCount() != testCase.wantErrors || resp.Diagnostics.WarningsCount() != testCase.wantWarns {
+				t.Errorf("diagnostics = %v, want %d errors and %d warnings", resp.Diagnostics, testCase.wantErrors, testCase.wantWarns)
+			}
+		})
+	}
+}
+
+func TestCheckNameserver(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name  string
+		valid bool
+	}{
+		{"ns1.example.com", true},
+		{"NS1.Example.COM.", true},
+		{"xn--bcher-kva.example", true},
+		{"ns1", false},
+		{"ns1..example.com", false},
+		{"-ns1.example.com", false},
+		{"ns_1.example.com", false},
+		{"ns1.example.com/", false},
+		{"192.0.2.1", false},
+		{strings.Repeat("a", 64) + ".example.com", false},
+		{strings.Repeat("a.", 127) + "com", false},
+	}
+
+	for _, testCase := range testCases {
+		err := checkNameserver(testCase.name)
+		if (err == nil) != testCase.valid {
+			t.Errorf("checkNameserver(%q) = %v, want valid %t", testCase.name, err, testCase.valid)
+		}
+	}
+}
+
 // nameserversV0State builds a schema-version-0 (list-shaped) prior state.
 func nameserversV0State(t *testing.T, priorSchema rschema.Schema) tfsdk.State {
 	t.Helper()
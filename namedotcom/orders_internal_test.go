@@ -0,0 +1,190 @@
+//nolint:paralleltest // The order tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// ordersClient serves three orders, of which ListOrders only includes the
+// items of the first; the others carry theirs in GetOrder.
+func ordersClient(t *testing.T) *namecom.NameCom {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/orders", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"orders":[`+
+			`{"id":1,"createDate":"2026-01-10T12:00:00Z","status":"success",`+
+			`"orderItems":[{"id":11,"type":"registration","name":"example.com","price":12.99}]},`+
+			`{"id":2,"createDate":"2026-02-10T12:00:00Z","status":"success"},`+
+			`{"id":3,"createDate":"2026-03-10T12:00:00Z","status":"failed"}]}`)
+	})
+	mux.HandleFunc("/v4/orders/3", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"id":3,"createDate":"2026-03-10T12:00:00Z","status":"failed",`+
+			`"orderItems":[{"id":31,"type":"registration","name":"example.net","price":9.99}]}`)
+	})
+	mux.HandleFunc("/v4/orders/2", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"id":2,"createDate":"2026-02-10T12:00:00Z","status":"success","totalRefund":4.99,`+
+			`"orderItems":[{"id":21,"type":"whois_privacy","name":"example.com","price":4.99,"status":"refunded"}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return namecom.Mock("u", "t", server.URL)
+}
+
+// TestOrdersRead_Filters pins the date, status and item type filters, with
+// items fetched from GetOrder when ListOrders leaves them out.
+func TestOrdersRead_Filters(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	cases := []struct {
+		name   string
+		config ordersModel
+		want   []int32
+	}{
+		{"no filter", ordersModel{}, []int32{1, 2, 3}},
+		{"status", ordersModel{Status: types.StringValue("SUCCESS")}, []int32{1, 2}},
+		{"date range", ordersModel{
+			CreatedAfter:  types.StringValue("2026-02-01T00:00:00Z"),
+			CreatedBefore: types.StringValue("2026-03-10T12:00:00Z"),
+		}, []int32{2}},
+		{"item type", ordersModel{ItemType: types.StringValue("whois_privacy")}, []int32{2}},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			src := &ordersDataSource{client: ordersClient(t)}
+
+			var schemaResp datasource.SchemaResponse
+
+			src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+			state := tfsdk.State{Schema: schemaResp.Schema}
+			state.Set(context.Background(), testCase.config)
+
+			resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+			src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(state)}, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got ordersModel
+
+			resp.State.Get(context.Background(), &got)
+
+			ids := make([]int32, 0, len(got.Orders))
+			for _, order := range got.Orders {
+				ids = append(ids, order.ID.ValueInt32())
+			}
+
+			if !slices.Equal(ids, testCase.want) {
+				t.Errorf("orders = %v, want %v", ids, testCase.want)
+			}
+		})
+	}
+}
+
+// TestOrdersRead_DateFormats confirms the date filter reads the other date
+// formats Name.com uses, and warns about orders whose date cannot be read
+// instead of dropping them silently.
+func TestOrdersRead_DateFormats(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/orders", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"orders":[`+
+			`{"id":1,"createDate":"2026-02-10 12:00:00","status":"success"},`+
+			`{"id":2,"createDate":"2026-02-11","status":"success"},`+
+			`{"id":3,"createDate":"last Tuesday","status":"success"}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	src := &ordersDataSource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp datasource.SchemaResponse
+
+	src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(context.Background(), ordersModel{CreatedAfter: types.StringValue("2026-02-01T00:00:00Z")})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(state)}, &resp)
+
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("diagnostics = %v, want one warning", resp.Diagnostics)
+	}
+
+	if !strings.Contains(resp.Diagnostics[0].Detail(), "3") {
+		t.Errorf("warning %q does not name order 3", resp.Diagnostics[0].Detail())
+	}
+
+	var got ordersModel
+
+	resp.State.Get(context.Background(), &got)
+
+	ids := make([]int32, 0, len(got.Orders))
+	for _, order := range got.Orders {
+		ids = append(ids, order.ID.ValueInt32())
+	}
+
+	if !slices.Equal(ids, []int32{1, 2}) {
+		t.Errorf("orders = %v, want [1 2]", ids)
+	}
+}
+
+// TestOrderRead confirms a single order is read with its items, and amounts
+// keep their decimal value.
+func TestOrderRead(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	src := &orderDataSource{client: ordersClient(t)}
+
+	var schemaResp datasource.SchemaResponse
+
+	src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(context.Background(), orderDataSourceModel{OrderID: types.Int32Value(2)})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(state)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got orderDataSourceModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.TotalRefund.ValueFloat64() != 4.99 {
+		t.Errorf("total_refund = %v, want 4.99", got.TotalRefund)
+	}
+
+	if len(got.Items) != 1 || got.Items[0].Status.ValueString() != "refunded" {
+		t.Errorf("items = %+v, want the refunded privacy item", got.Items)
+	}
+}
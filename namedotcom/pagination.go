@@ -0,0 +1,68 @@
+package namedotcom
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+)
+
+// errIncompleteList is returned when a list endpoint reports more pages but
+// serves nothing new, so the list cannot be fetched in full.
+var errIncompleteList = errors.New("list is incomplete")
+
+// listAllPages fetches every page of a Name.com list endpoint, following
+// NextPage until the last page. fetch returns the items and NextPage of one
+// page; items are de-duplicated by key.
+//
+// The SDK does not forward the page query parameter, so every request returns
+// the first page. A page that adds nothing new therefore means the rest of the
+// list is out of reach, and is reported as an error instead of returning a
+// silently truncated list.
+func listAllPages[T any, K comparable](
+	ctx context.Context,
+	method string,
+	fetch func(page int32) ([]T, int32, error),
+	key func(T) K,
+) ([]T, error) {
+	var items []T
+
+	seen := make(map[K]bool)
+
+	for page := int32(1); ; {
+		err := RespectRateLimits(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "rate limiting error")
+		}
+
+		pageItems, nextPage, err := fetch(page)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Error %s", method)
+		}
+
+		added := 0
+
+		for _, item := range pageItems {
+			if seen[key(item)] {
+				continue
+			}
+
+			seen[key(item)] = true
+			items = append(items, item)
+			added++
+		}
+
+		// A page of items already seen is an earlier page served again, and an
+		// empty page that points further makes no progress either.
+		if added == 0 && (len(pageItems) > 0 || nextPage > page) {
+			return nil, errors.Wrapf(errIncompleteList,
+				"%s returned nothing new for page %d; %d items were fetched before the walk stopped",
+				method, page, len(items))
+		}
+
+		if nextPage <= page {
+			return items, nil
+		}
+
+		page = nextPage
+	}
+}
@@ -0,0 +1,54 @@
+package namedotcom
+
+import (
+	"context"
+	"testing"
+
+	"github.com/cockroachdb/errors"
+)
+
+// pages serves fixed pages of integers, each with its NextPage, ignoring the
+// page asked for when repeat is set, as the SDK does.
+type pages struct {
+	items  [][]int
+	next   []int32
+	repeat bool
+	calls  int
+}
+
+func (p *pages) fetch(page int32) ([]int, int32, error) {
+	p.calls++
+
+	if p.repeat {
+		page = 1
+	}
+
+	return p.items[page-1], p.next[page-1], nil
+}
+
+//nolint:paralleltest // listAllPages uses the global rate limiter.
+func TestListAllPages(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	identity := func(item int) int { return item }
+
+	// Overlapping pages are merged without duplicates.
+	server := &pages{items: [][]int{{1, 2}, {2, 3}}, next: []int32{2, 0}}
+
+	items, err := listAllPages(context.Background(), "List", server.fetch, identity)
+	if err != nil || len(items) != 3 || server.calls != 2 {
+		t.Errorf("got %v, %v in %d calls, want [1 2 3] in 2 calls", items, err, server.calls)
+	}
+
+	// A page that repeats while NextPage points further is an error.
+	server = &pages{items: [][]int{{1, 2}, {3}}, next: []int32{2, 0}, repeat: true}
+
+	_, err = listAllPages(context.Background(), "List", server.fetch, identity)
+	if !errors.Is(err, errIncompleteList) {
+		t.Errorf("err = %v, want errIncompleteList", err)
+	}
+
+	if server.calls != 2 {
+		t.Errorf("fetched %d pages, want 2", server.calls)
+	}
+}
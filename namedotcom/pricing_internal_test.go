@@ -0,0 +1,122 @@
+//nolint:paralleltest // The pricing tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// TestCheckAvailabilityBatchAPI confirms names are checked in batches of 50
+// and the results come back in the requested order, whatever the API's order.
+func TestCheckAvailabilityBatchAPI(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	var batches []int
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains:checkAvailability", func(writer http.ResponseWriter, request *http.Request) {
+		var body namecom.AvailabilityRequest
+
+		json.NewDecoder(request.Body).Decode(&body)
+		batches = append(batches, len(body.DomainNames))
+
+		results := make([]*namecom.SearchResult, 0, len(body.DomainNames))
+		for _, name := range slices.Backward(body.DomainNames) {
+			results = append(results, &namecom.SearchResult{DomainName: name, Purchasable: true})
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(writer).Encode(&namecom.SearchResponse{Results: results})
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	names := make([]string, 0, 60)
+	for index := range 60 {
+		names = append(names, fmt.Sprintf("example%d.com", index))
+	}
+
+	results, err := checkAvailabilityBatchAPI(context.Background(), namecom.Mock("u", "t", server.URL), names)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !slices.Equal(batches, []int{50, 10}) {
+		t.Errorf("batches = %v, want [50 10]", batches)
+	}
+
+	for index, result := range results {
+		if result.DomainName != names[index] {
+			t.Fatalf("results[%d] = %s, want %s", index, result.DomainName, names[index])
+		}
+	}
+}
+
+// TestDomainPricingRead confirms the prices come from GetPricingForDomain,
+// multiplied by years, and purchasable and purchase_type from the
+// availability check.
+func TestDomainPricingRead(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com:getPricing", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"purchasePrice":12.99,"renewalPrice":13.99,"transferPrice":12.99}`)
+	})
+	mux.HandleFunc("/v4/domains:checkAvailability", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"results":[{"domainName":"example.com","purchasable":true,"purchasePrice":12.99}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	src := &domainPricingDataSource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp datasource.SchemaResponse
+
+	src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	config.Set(context.Background(), &domainPricingModel{
+		DomainName:    types.StringValue("example.com"),
+		Years:         types.Int32Value(3),
+		Purchasable:   types.BoolNull(),
+		Premium:       types.BoolNull(),
+		PurchasePrice: types.Float64Null(),
+		RenewalPrice:  types.Float64Null(),
+		PurchaseType:  types.StringNull(),
+		TransferPrice: types.Float64Null(),
+	})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(config)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got domainPricingModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.PurchasePrice.ValueFloat64() != 38.97 || got.RenewalPrice.ValueFloat64() != 41.97 {
+		t.Errorf("purchase_price = %v, renewal_price = %v, want the 3-year prices", got.PurchasePrice, got.RenewalPrice)
+	}
+
+	if !got.Purchasable.ValueBool() || got.PurchaseType.ValueString() != purchaseTypeRegistration {
+		t.Errorf("purchasable = %v, purchase_type = %v, want a purchasable registration", got.Purchasable, got.PurchaseType)
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"os"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
@@ -26,8 +29,13 @@ const (
 	defaultTimeoutSeconds     = 120
 )
 
-// Ensure the provider satisfies the framework interface.
-var _ provider.Provider = (*nameDotComProvider)(nil)
+// Ensure the provider satisfies the framework interfaces.
+var (
+	_ provider.Provider                       = (*nameDotComProvider)(nil)
+	_ provider.ProviderWithFunctions          = (*nameDotComProvider)(nil)
+	_ provider.ProviderWithEphemeralResources = (*nameDotComProvider)(nil)
+	_ provider.ProviderWithActions            = (*nameDotComProvider)(nil)
+)
 
 // nameDotComProvider is the Name.com provider implementation.
 type nameDotComProvider struct {
@@ -49,6 +57,7 @@ type providerModel struct {
 	RateLimitPerSecond types.Int64  `tfsdk:"rate_limit_per_second"`
 	RateLimitPerHour   types.Int64  `tfsdk:"rate_limit_per_hour"`
 	Timeout            types.Int64  `tfsdk:"timeout"`
+	RecordCache        types.Bool   `tfsdk:"record_cache"`
 }
 
 func (p *nameDotComProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -80,6 +89,11 @@ func (p *nameDotComProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				Optional:    true,
 				Description: "Timeout in seconds for API requests. Defaults to 120 seconds.",
 			},
+			keyRecordCache: schema.BoolAttribute{
+				Optional: true,
+				//nolint:lll // One sentence describing the cache and its trade-off.
+				Description: "Whether to list each domain's records once and serve record refreshes and plan-time checks from that listing, instead of one GetRecord call per record. The listing is dropped whenever the provider changes a record in the domain. Defaults to true. When false, the CNAME check of every planned record lists all of its domain's records, so plans of large zones make many more API calls.",
+			},
 		},
 	}
 }
@@ -134,9 +148,12 @@ func (p *nameDotComProvider) Configure(ctx context.Context, req provider.Configu
 	}
 
 	client := buildClient(username, token, cfg.RateLimitPerSecond, cfg.RateLimitPerHour, cfg.Timeout)
+	data := newProviderData(client, cfg.RecordCache.IsNull() || cfg.RecordCache.ValueBool())
 
-	resp.ResourceData = client
-	resp.DataSourceData = client
+	resp.ResourceData = data
+	resp.DataSourceData = data
+	resp.EphemeralResourceData = data
+	resp.ActionData = data
 }
 
 func (p *nameDotComProvider) Resources(_ context.Context) []func() resource.Resource {
@@ -144,11 +161,47 @@ func (p *nameDotComProvider) Resources(_ context.Context) []func() resource.Reso
 		NewRecordResource,
 		NewDomainNameServersResource,
 		NewDNSSECResource,
+		NewDomainResource,
+		NewDomainAutorenewResource,
+		NewDomainLockResource,
+		NewDomainContactsResource,
+		NewDomainTransferResource,
+		NewWhoisPrivacyResource,
+		NewDNSSECKeysResource,
 	}
 }
 
 func (p *nameDotComProvider) DataSources(_ context.Context) []func() datasource.DataSource {
-	return nil
+	return []func() datasource.DataSource{
+		NewZoneFileDataSource,
+		NewDomainAvailabilityDataSource,
+		NewDomainPricingDataSource,
+		NewDomainSearchDataSource,
+		NewOrdersDataSource,
+		NewOrderDataSource,
+		NewTransfersDataSource,
+		NewExpiringDomainsDataSource,
+		NewDNSSECDataSource,
+	}
+}
+
+func (p *nameDotComProvider) EphemeralResources(_ context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewDomainAuthCodeEphemeralResource,
+	}
+}
+
+func (p *nameDotComProvider) Actions(_ context.Context) []func() action.Action {
+	return []func() action.Action{
+		NewRenewDomainAction,
+	}
+}
+
+func (p *nameDotComProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewParseZoneFileFunction,
+		NewDSFromDNSKEYFunction,
+	}
 }
 
 // resolveCredentials returns the effective username/token, preferring the
@@ -216,24 +269,46 @@ func buildClient(username, token string, perSecond, perHour, timeout types.Int64
 	return client
 }
 
-// configureClient extracts the *namecom.NameCom client from the data the
-// provider passes to each resource's Configure method. It returns false (and
-// raises no error) when ProviderData is nil, which is the normal state before
-// the provider's own Configure has run.
-func configureClient(providerData any, diags *diag.Diagnostics) (*namecom.NameCom, bool) {
-	if providerData == nil {
+// providerData is what the provider passes to each resource and data source:
+// the API client and the state shared across them for one provider instance.
+type providerData struct {
+	client  *namecom.NameCom
+	records *recordCache
+}
+
+func newProviderData(client *namecom.NameCom, cacheRecords bool) *providerData {
+	return &providerData{client: client, records: newRecordCache(cacheRecords)}
+}
+
+// configureProviderData extracts the *providerData the provider passes to each
+// resource's Configure method. It returns false (and raises no error) when
+// ProviderData is nil, which is the normal state before the provider's own
+// Configure has run.
+func configureProviderData(rawData any, diags *diag.Diagnostics) (*providerData, bool) {
+	if rawData == nil {
 		return nil, false
 	}
 
-	client, ok := providerData.(*namecom.NameCom)
+	data, ok := rawData.(*providerData)
 	if !ok {
 		diags.AddError(
 			"Unexpected provider data type",
-			fmt.Sprintf("Expected *namecom.NameCom, got %T. This is a bug in the provider.", providerData),
+			fmt.Sprintf("Expected *namedotcom.providerData, got %T. This is a bug in the provider.", rawData),
 		)
 
 		return nil, false
 	}
 
-	return client, true
+	return data, true
+}
+
+// configureClient is configureProviderData for the many resources that only
+// need the *namecom.NameCom client.
+func configureClient(rawData any, diags *diag.Diagnostics) (*namecom.NameCom, bool) {
+	data, ok := configureProviderData(rawData, diags)
+	if !ok {
+		return nil, false
+	}
+
+	return data.client, true
 }
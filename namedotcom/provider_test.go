@@ -61,8 +61,8 @@ func TestProviderResources(t *testing.T) {
 	prov := namedotcom.New("test")()
 
 	resources := prov.Resources(context.Background())
-	if len(resources) != 3 {
-		t.Fatalf("expected 3 resources, got %d", len(resources))
+	if len(resources) != 10 {
+		t.Fatalf("expected 10 resources, got %d", len(resources))
 	}
 }
 
@@ -146,7 +146,7 @@ func TestResolveCredentials_ExplicitEmptyDoesNotFallBack(t *testing.T) {
 func TestConfigureClient_Valid(t *testing.T) {
 	var diags diag.Diagnostics
 
-	client, ok := namedotcom.ConfigureClient(&namecom.NameCom{}, &diags)
+	client, ok := namedotcom.ConfigureClient(namedotcom.NewProviderData(&namecom.NameCom{}, true), &diags)
 
 	if !ok || client == nil {
 		t.Fatal("expected a valid client")
@@ -160,7 +160,7 @@ func TestConfigureClient_Valid(t *testing.T) {
 func TestConfigureClient_WrongType(t *testing.T) {
 	var diags diag.Diagnostics
 
-	client, ok := namedotcom.ConfigureClient("not a client", &diags)
+	client, ok := namedotcom.ConfigureClient(&namecom.NameCom{}, &diags)
 
 	if ok || client != nil {
 		t.Error("expected failure for the wrong provider data type")
@@ -0,0 +1,129 @@
+package namedotcom
+
+import (
+	"net/netip"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+)
+
+// Limits on DNS names, shared by every check of a name in the provider, and on
+// the text a record answer can hold.
+const (
+	// dnsNameMaxLength is the longest domain name in presentation format,
+	// without the trailing dot (RFC 1035 section 2.3.4).
+	dnsNameMaxLength = 253
+	// dnsNameMaxWireLength is the same limit in wire format, counting the
+	// length octet of each label and the root label.
+	dnsNameMaxWireLength = 255
+	// dnsLabelMaxLength is the longest single label (RFC 1035 section 2.3.4).
+	dnsLabelMaxLength = 63
+	// txtMaxLength is the longest TXT answer Name.com accepts.
+	txtMaxLength = 2048
+	// srvAnswerFields is the number of fields in an SRV answer: Name.com keeps
+	// the priority separately and stores "weight port target".
+	srvAnswerFields = 3
+)
+
+// checkRecordAnswer reports why an answer cannot be stored for the record type:
+//   - A needs an IPv4 address and AAAA an IPv6 address;
+//   - ANAME, CNAME, MX and NS need a host name, not an IP address;
+//   - SRV needs "weight port target" with 16-bit weight and port;
+//   - TXT must fit Name.com's length limit.
+//
+// Record types it does not know are accepted and left to the API.
+func checkRecordAnswer(recordType, answer string) error {
+	switch recordType {
+	case recordTypeA:
+		addr, err := netip.ParseAddr(answer)
+		if err != nil || !addr.Is4() {
+			return errors.New("expected an IPv4 address")
+		}
+	case recordTypeAAAA:
+		addr, err := netip.ParseAddr(answer)
+		if err != nil || !addr.Is6() || addr.Is4In6() || addr.Zone() != "" {
+			return errors.New("expected an IPv6 address")
+		}
+	case recordTypeANAME, recordTypeCNAME, recordTypeMX, recordTypeNS:
+		return checkTargetName(answer)
+	case recordTypeSRV:
+		return checkSRVAnswer(answer)
+	case recordTypeTXT:
+		if len(answer) > txtMaxLength {
+			return errors.Newf("Name.com limits TXT answers to %d characters, got %d", txtMaxLength, len(answer))
+		}
+	}
+
+	return nil
+}
+
+// checkSRVAnswer validates the "weight port target" form of an SRV answer.
+func checkSRVAnswer(answer string) error {
+	fields := strings.Fields(answer)
+	if len(fields) != srvAnswerFields {
+		return errors.New(`expected "weight port target"; the priority goes in the priority attribute`)
+	}
+
+	for _, field := range fields[:2] {
+		_, err := strconv.ParseUint(field, 10, 16)
+		if err != nil {
+			return errors.Newf("weight and port must be 0-65535, got %q", field)
+		}
+	}
+
+	// A lone "." target means the service is explicitly not available.
+	if fields[2] == "." {
+		return nil
+	}
+
+	return checkTargetName(fields[2])
+}
+
+// checkTargetName validates a host name used as a record target. Internationalized
+// names must be given in punycode.
+func checkTargetName(name string) error {
+	if _, err := netip.ParseAddr(name); err == nil {
+		return errors.New("expected a host name, not an IP address")
+	}
+
+	trimmed := strings.TrimSuffix(name, ".")
+	if trimmed == "" {
+		return errors.New("expected a host name")
+	}
+
+	if len(trimmed) > dnsNameMaxLength {
+		return errors.Newf("host names are limited to %d characters", dnsNameMaxLength)
+	}
+
+	for _, label := range strings.Split(trimmed, ".") {
+		err := checkLabel(label)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkLabel validates one label of a host name: letters, digits, hyphens and
+// underscores (used by service names such as _sip._tcp), not starting or
+// ending with a hyphen.
+func checkLabel(label string) error {
+	if label == "" || len(label) > dnsLabelMaxLength {
+		return errors.Newf("each label of a host name must be 1-%d characters", dnsLabelMaxLength)
+	}
+
+	if strings.HasPrefix(label, "-") || strings.HasSuffix(label, "-") {
+		return errors.Newf("label %q must not start or end with a hyphen", label)
+	}
+
+	for _, char := range label {
+		isAlnum := (char >= 'a' && char <= 'z') || (char >= 'A' && char <= 'Z') || (char >= '0' && char <= '9')
+		if !isAlnum && char != '-' && char != '_' {
+			return errors.Newf("label %q contains %q; use letters, digits, hyphens and punycode for international names", label, char)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package namedotcom
+
+import (
+	"context"
+	"sync"
+
+	"github.com/namedotcom/go/v4/namecom"
+	"golang.org/x/sync/singleflight"
+)
+
+// recordCache holds the records of each domain, listed once per provider
+// instance, so refreshing or planning many records in one zone shares a single
+// paginated ListRecords walk instead of issuing a GetRecord per record.
+// Concurrent lookups of a domain that is not cached yet join one in-flight
+// walk. Writes through the record resource invalidate the domain so a later
+// lookup sees the change.
+//
+// When disabled, every lookup lists the records afresh and nothing is kept.
+type recordCache struct {
+	enabled bool
+	group   singleflight.Group
+
+	mu      sync.Mutex
+	domains map[string][]*namecom.Record
+	// generations counts the invalidations of each domain, so a walk that
+	// started before an invalidation does not store its stale result.
+	generations map[string]uint64
+}
+
+func newRecordCache(enabled bool) *recordCache {
+	return &recordCache{
+		enabled:     enabled,
+		domains:     make(map[string][]*namecom.Record),
+		generations: make(map[string]uint64),
+	}
+}
+
+// list returns the records of a domain, listing them via the API on first use.
+// The returned slice is shared and must not be modified.
+func (c *recordCache) list(ctx context.Context, client *namecom.NameCom, domainName string) ([]*namecom.Record, error) {
+	if !c.enabled {
+		return listRecordsAPI(ctx, client, domainName)
+	}
+
+	key := hostKey(domainName)
+
+	c.mu.Lock()
+	records, ok := c.domains[key]
+	generation := c.generations[key]
+	c.mu.Unlock()
+
+	if ok {
+		return records, nil
+	}
+
+	result, err, _ := c.group.Do(key, func() (any, error) {
+		listed, err := listRecordsAPI(ctx, client, domainName)
+		if err != nil {
+			return nil, err
+		}
+
+		c.mu.Lock()
+		if c.generations[key] == generation {
+			c.domains[key] = listed
+		}
+		c.mu.Unlock()
+
+		return listed, nil
+	})
+	if err != nil {
+		return nil, err //nolint:wrapcheck // listRecordsAPI already wraps the error.
+	}
+
+	listed, _ := result.([]*namecom.Record)
+
+	return listed, nil
+}
+
+// find returns the record with the given ID from the domain's listing, or
+// false when the cache is disabled, the listing failed, or the record is not
+// in it; callers then fall back to GetRecord, which is authoritative.
+func (c *recordCache) find(ctx context.Context, client *namecom.NameCom, domainName string, recordID int32) (*namecom.Record, bool) {
+	if !c.enabled {
+		return nil, false
+	}
+
+	records, err := c.list(ctx, client, domainName)
+	if err != nil {
+		return nil, false
+	}
+
+	for _, record := range records {
+		if record.ID == recordID {
+			return record, true
+		}
+	}
+
+	return nil, false
+}
+
+// invalidate drops the cached records of a domain, and makes any walk already
+// in flight neither store its result nor be joined by later lookups.
+func (c *recordCache) invalidate(domainName string) {
+	key := hostKey(domainName)
+
+	c.mu.Lock()
+	delete(c.domains, key)
+	c.generations[key]++
+	c.mu.Unlock()
+
+	c.group.Forget(key)
+}
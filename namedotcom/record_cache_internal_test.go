@@ -0,0 +1,163 @@
+//nolint:paralleltest // The cache tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// cacheTestServer serves ListRecords for example.com with two records, counts
+// list calls, and answers GetRecord for any other record with a 404. Listing
+// is slowed down so concurrent lookups overlap.
+func cacheTestServer(t *testing.T) (*namecom.NameCom, *atomic.Int32, *atomic.Int32) {
+	t.Helper()
+
+	var lists, gets atomic.Int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com/records", func(writer http.ResponseWriter, _ *http.Request) {
+		lists.Add(1)
+		time.Sleep(20 * time.Millisecond)
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"records":[`+
+			`{"id":1,"domainName":"example.com","host":"www","type":"A","answer":"192.0.2.1"},`+
+			`{"id":2,"domainName":"example.com","host":"mail","type":"A","answer":"192.0.2.2"}]}`)
+	})
+	mux.HandleFunc("/v4/domains/example.com/records/", func(writer http.ResponseWriter, _ *http.Request) {
+		gets.Add(1)
+		http.Error(writer, `{"message":"Record not found"}`, http.StatusNotFound)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return namecom.Mock("u", "t", server.URL), &lists, &gets
+}
+
+// TestRecordCache_SingleFlight confirms concurrent lookups of an uncached
+// domain share one ListRecords walk, and later lookups are served from memory.
+func TestRecordCache_SingleFlight(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	client, lists, _ := cacheTestServer(t)
+	cache := newRecordCache(true)
+
+	var wg sync.WaitGroup
+
+	for range 10 {
+		wg.Go(func() {
+			records, err := cache.list(context.Background(), client, "example.com")
+			if err != nil || len(records) != 2 {
+				t.Errorf("list = %d records, %v", len(records), err)
+			}
+		})
+	}
+
+	wg.Wait()
+
+	if _, ok := cache.find(context.Background(), client, "EXAMPLE.com.", 2); !ok {
+		t.Error("expected record 2 to be served from the cache")
+	}
+
+	if got := lists.Load(); got != 1 {
+		t.Errorf("ListRecords calls = %d, want 1", got)
+	}
+}
+
+// TestRecordCache_Invalidate confirms an invalidated domain is listed again.
+func TestRecordCache_Invalidate(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	client, lists, _ := cacheTestServer(t)
+	cache := newRecordCache(true)
+
+	cache.list(context.Background(), client, "example.com")
+	cache.invalidate("example.com")
+	cache.list(context.Background(), client, "example.com")
+
+	if got := lists.Load(); got != 2 {
+		t.Errorf("ListRecords calls = %d, want 2", got)
+	}
+}
+
+// TestRecordCache_Disabled confirms a disabled cache keeps nothing and never
+// serves finds, so Read falls back to GetRecord.
+func TestRecordCache_Disabled(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	client, lists, _ := cacheTestServer(t)
+	cache := newRecordCache(false)
+
+	cache.list(context.Background(), client, "example.com")
+	cache.list(context.Background(), client, "example.com")
+
+	if _, ok := cache.find(context.Background(), client, "example.com", 1); ok {
+		t.Error("a disabled cache must not serve finds")
+	}
+
+	if got := lists.Load(); got != 2 {
+		t.Errorf("ListRecords calls = %d, want 2", got)
+	}
+}
+
+// TestRecordRead_ServedFromCache drives Read for several records of one domain
+// and confirms one ListRecords walk replaces the per-record GetRecord calls,
+// while a record missing from the listing still goes to GetRecord (and is
+// removed from state on its 404).
+func TestRecordRead_ServedFromCache(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	client, lists, gets := cacheTestServer(t)
+	res := &recordResource{client: client, records: newRecordCache(true)}
+
+	read := func(recordID string) resource.ReadResponse {
+		state := recordState(t, recordModel{
+			ID:         types.StringValue(recordID),
+			DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		})
+		resp := resource.ReadResponse{State: state}
+
+		res.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		return resp
+	}
+
+	read("1")
+
+	resp := read("2")
+
+	var got recordModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.Host.ValueString() != "mail" {
+		t.Errorf("host = %q, want %q", got.Host.ValueString(), "mail")
+	}
+
+	if lists.Load() != 1 || gets.Load() != 0 {
+		t.Errorf("ListRecords = %d, GetRecord = %d; want 1 and 0", lists.Load(), gets.Load())
+	}
+
+	if !read("3").State.Raw.IsNull() {
+		t.Error("a record missing from the listing and from GetRecord must be removed from state")
+	}
+
+	if gets.Load() != 1 {
+		t.Errorf("GetRecord = %d, want 1 for the record missing from the listing", gets.Load())
+	}
+}
@@ -6,8 +6,10 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -26,10 +28,10 @@ import (
 // would fail Terraform's "inconsistent result after apply" check.
 func TestRecordCreateState(t *testing.T) {
 	plan := recordModel{
-		DomainName: types.StringValue("example.com"),
-		Host:       types.StringValue("www"),
-		RecordType: types.StringValue("cname"),
-		Answer:     types.StringValue("bar.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Host:       hostnameValue{StringValue: types.StringValue("www")},
+		RecordType: recordTypeValue{StringValue: types.StringValue("cname")},
+		Answer:     answerValue{StringValue: types.StringValue("bar.com")},
 	}
 
 	record := &namecom.Record{
@@ -66,10 +68,10 @@ func TestRecordCreateState(t *testing.T) {
 func TestRecordReadState_PreservesConfiguredRepresentation(t *testing.T) {
 	state := recordModel{
 		ID:         types.StringValue("42"),
-		DomainName: types.StringValue("example.com"),
-		Host:       types.StringValue("www"),
-		RecordType: types.StringValue("cname"),
-		Answer:     types.StringValue("bar.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Host:       hostnameValue{StringValue: types.StringValue("www")},
+		RecordType: recordTypeValue{StringValue: types.StringValue("cname")},
+		Answer:     answerValue{StringValue: types.StringValue("bar.com")},
 	}
 
 	record := &namecom.Record{
@@ -100,7 +102,7 @@ func TestRecordReadState_PreservesConfiguredRepresentation(t *testing.T) {
 func TestRecordReadState_AdoptsAPIValueWhenStateEmpty(t *testing.T) {
 	state := recordModel{
 		ID:         types.StringValue("42"),
-		DomainName: types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
 	}
 
 	record := &namecom.Record{
@@ -136,10 +138,10 @@ func TestRecordReadState_UpgradesZeroRecordID(t *testing.T) {
 	state := recordModel{
 		ID:         types.StringValue("42"),
 		RecordID:   types.Int32Value(0),
-		DomainName: types.StringValue("example.com"),
-		Host:       types.StringValue("www"),
-		RecordType: types.StringValue("A"),
-		Answer:     types.StringValue("192.0.2.1"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Host:       hostnameValue{StringValue: types.StringValue("www")},
+		RecordType: recordTypeValue{StringValue: types.StringValue("A")},
+		Answer:     answerValue{StringValue: types.StringValue("192.0.2.1")},
 	}
 
 	record := &namecom.Record{ID: 42, DomainName: "example.com", Host: "www", Type: "A", Answer: "192.0.2.1"}
@@ -158,10 +160,10 @@ func TestRecordReadState_PreservesApexHost(t *testing.T) {
 
 	state := recordModel{
 		ID:         types.StringValue("42"),
-		DomainName: types.StringValue("example.com"),
-		Host:       types.StringValue("@"),
-		RecordType: types.StringValue("A"),
-		Answer:     types.StringValue("192.0.2.1"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Host:       hostnameValue{StringValue: types.StringValue("@")},
+		RecordType: recordTypeValue{StringValue: types.StringValue("A")},
+		Answer:     answerValue{StringValue: types.StringValue("192.0.2.1")},
 	}
 
 	record := &namecom.Record{ID: 42, DomainName: "example.com", Host: "", Type: "A", Answer: "192.0.2.1"}
@@ -180,7 +182,7 @@ func TestRecordReadState_AdoptsApexHostOnImport(t *testing.T) {
 
 	state := recordModel{
 		ID:         types.StringValue("42"),
-		DomainName: types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
 	}
 
 	record := &namecom.Record{ID: 42, DomainName: "example.com", Host: "", Type: "A", Answer: "192.0.2.1"}
@@ -197,10 +199,10 @@ func TestRecordReadState_AdoptsApexHostOnImport(t *testing.T) {
 func TestRecordReadState_DetectsRealDrift(t *testing.T) {
 	state := recordModel{
 		ID:         types.StringValue("42"),
-		DomainName: types.StringValue("example.com"),
-		Host:       types.StringValue("www"),
-		RecordType: types.StringValue("A"),
-		Answer:     types.StringValue("1.2.3.4"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Host:       hostnameValue{StringValue: types.StringValue("www")},
+		RecordType: recordTypeValue{StringValue: types.StringValue("A")},
+		Answer:     answerValue{StringValue: types.StringValue("1.2.3.4")},
 	}
 
 	record := &namecom.Record{
@@ -237,10 +239,10 @@ func TestRecordRead_RemovesResourceOnNotFound(t *testing.T) {
 	req := resource.ReadRequest{
 		State: recordState(t, recordModel{
 			ID:         types.StringValue("42"),
-			DomainName: types.StringValue("example.com"),
-			Host:       types.StringValue("www"),
-			RecordType: types.StringValue("A"),
-			Answer:     types.StringValue("1.2.3.4"),
+			DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+			Host:       hostnameValue{StringValue: types.StringValue("www")},
+			RecordType: recordTypeValue{StringValue: types.StringValue("A")},
+			Answer:     answerValue{StringValue: types.StringValue("1.2.3.4")},
 		}),
 	}
 	resp := resource.ReadResponse{State: recordState(t, recordModel{ID: types.StringValue("42")})}
@@ -277,10 +279,10 @@ func TestRecordCreate_SetsState(t *testing.T) {
 		Plan: recordPlan(t, recordModel{
 			ID:         types.StringNull(),
 			RecordID:   types.Int32Null(),
-			DomainName: types.StringValue("example.com"),
-			Host:       types.StringValue("www"),
-			RecordType: types.StringValue("A"),
-			Answer:     types.StringValue("192.0.2.1"),
+			DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+			Host:       hostnameValue{StringValue: types.StringValue("www")},
+			RecordType: recordTypeValue{StringValue: types.StringValue("A")},
+			Answer:     answerValue{StringValue: types.StringValue("192.0.2.1")},
 		}),
 	}
 	resp := resource.CreateResponse{State: recordState(t, recordModel{ID: types.StringNull()})}
@@ -328,10 +330,10 @@ func TestRecordUpdate_SetsState(t *testing.T) {
 		return recordModel{
 			ID:         types.StringValue("42"),
 			RecordID:   types.Int32Value(42),
-			DomainName: types.StringValue("example.com"),
-			Host:       types.StringValue("www"),
-			RecordType: types.StringValue("A"),
-			Answer:     types.StringValue(answer),
+			DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+			Host:       hostnameValue{StringValue: types.StringValue("www")},
+			RecordType: recordTypeValue{StringValue: types.StringValue("A")},
+			Answer:     answerValue{StringValue: types.StringValue(answer)},
 		}
 	}
 
@@ -372,10 +374,10 @@ func TestRecordUpdate_RemovesResourceOnNotFound(t *testing.T) {
 	model := recordModel{
 		ID:         types.StringValue("42"),
 		RecordID:   types.Int32Value(42),
-		DomainName: types.StringValue("example.com"),
-		Host:       types.StringValue("www"),
-		RecordType: types.StringValue("A"),
-		Answer:     types.StringValue("192.0.2.9"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Host:       hostnameValue{StringValue: types.StringValue("www")},
+		RecordType: recordTypeValue{StringValue: types.StringValue("A")},
+		Answer:     answerValue{StringValue: types.StringValue("192.0.2.9")},
 	}
 
 	req := resource.UpdateRequest{Plan: recordPlan(t, model), State: recordState(t, model)}
@@ -409,7 +411,7 @@ func TestRecordDelete_Succeeds(t *testing.T) {
 
 	req := resource.DeleteRequest{State: recordState(t, recordModel{
 		ID:         types.StringValue("42"),
-		DomainName: types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
 	})}
 
 	res.Delete(context.Background(), req, &resource.DeleteResponse{})
@@ -459,7 +461,7 @@ func TestRecordRead_InvalidIDErrors(t *testing.T) {
 
 	state := recordModel{
 		ID:         types.StringValue("not-a-number"),
-		DomainName: types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
 	}
 
 	resp := resource.ReadResponse{State: recordState(t, state)}
@@ -477,9 +479,9 @@ func TestRecordRead_InvalidIDErrors(t *testing.T) {
 // the API's canonical form) from destroying and recreating resources — while a
 // genuine value change still does.
 func TestRequiresReplaceOnDNSChange(t *testing.T) {
-	mod := requiresReplaceOnDNSChange()
+	mod := requiresReplaceOnDNSChange[hostnameSemantics]()
 
-	base := recordModel{ID: types.StringValue("42"), DomainName: types.StringValue("example.com")}
+	base := recordModel{ID: types.StringValue("42"), DomainName: hostnameValue{StringValue: types.StringValue("example.com")}}
 
 	cases := []struct {
 		name        string
@@ -563,10 +565,10 @@ func TestRecordCreate_MXSetsPriority(t *testing.T) {
 		Plan: recordPlan(t, recordModel{
 			ID:         types.StringNull(),
 			RecordID:   types.Int32Null(),
-			DomainName: types.StringValue("example.com"),
-			Host:       types.StringValue(""),
-			RecordType: types.StringValue("MX"),
-			Answer:     types.StringValue("mail.example.com"),
+			DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+			Host:       hostnameValue{StringValue: types.StringValue("")},
+			RecordType: recordTypeValue{StringValue: types.StringValue("MX")},
+			Answer:     answerValue{StringValue: types.StringValue("mail.example.com")},
 			Priority:   types.Int32Value(10),
 		}),
 	}
@@ -596,15 +598,16 @@ func TestRecordValidateConfig_PriorityRecordType(t *testing.T) {
 	cases := []struct {
 		name       string
 		recordType string
+		answer     string
 		priority   types.Int32
 		wantErr    bool
 	}{
-		{"priority on MX is allowed", "MX", types.Int32Value(10), false},
-		{"priority on SRV is allowed", "SRV", types.Int32Value(10), false},
-		{"priority on lowercase mx is allowed", "mx", types.Int32Value(10), false},
-		{"priority on A is rejected", "A", types.Int32Value(10), true},
-		{"priority on CNAME is rejected", "CNAME", types.Int32Value(10), true},
-		{"no priority on A is allowed", "A", types.Int32Null(), false},
+		{"priority on MX is allowed", "MX", "mail.example.com", types.Int32Value(10), false},
+		{"priority on SRV is allowed", "SRV", "5 5060 sip.example.com", types.Int32Value(10), false},
+		{"priority on lowercase mx is allowed", "mx", "mail.example.com", types.Int32Value(10), false},
+		{"priority on A is rejected", "A", "192.0.2.1", types.Int32Value(10), true},
+		{"priority on CNAME is rejected", "CNAME", "bar.com", types.Int32Value(10), true},
+		{"no priority on A is allowed", "A", "192.0.2.1", types.Int32Null(), false},
 	}
 
 	for _, testCase := range cases {
@@ -614,10 +617,10 @@ func TestRecordValidateConfig_PriorityRecordType(t *testing.T) {
 			res := &recordResource{}
 			req := resource.ValidateConfigRequest{
 				Config: recordConfig(t, recordModel{
-					DomainName: types.StringValue("example.com"),
-					Host:       types.StringValue(""),
-					RecordType: types.StringValue(testCase.recordType),
-					Answer:     types.StringValue("mail.example.com"),
+					DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+					Host:       hostnameValue{StringValue: types.StringValue("")},
+					RecordType: recordTypeValue{StringValue: types.StringValue(testCase.recordType)},
+					Answer:     answerValue{StringValue: types.StringValue(testCase.answer)},
 					Priority:   testCase.priority,
 				}),
 			}
@@ -727,10 +730,10 @@ func TestRecordUpdate_MXPriorityReset(t *testing.T) {
 		return recordModel{
 			ID:         types.StringValue("42"),
 			RecordID:   types.Int32Value(42),
-			DomainName: types.StringValue("example.com"),
-			Host:       types.StringValue(""),
-			RecordType: types.StringValue("MX"),
-			Answer:     types.StringValue("mail.example.com"),
+			DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+			Host:       hostnameValue{StringValue: types.StringValue("")},
+			RecordType: recordTypeValue{StringValue: types.StringValue("MX")},
+			Answer:     answerValue{StringValue: types.StringValue("mail.example.com")},
 			Priority:   priority,
 		}
 	}
@@ -789,7 +792,7 @@ func TestRecordValidateConfig_DefersOnUnknown(t *testing.T) {
 	}{
 		{"unknown record_type defers", types.StringUnknown(), types.Int32Value(10)},
 		{"null record_type defers", types.StringNull(), types.Int32Value(10)},
-		{"unknown priority defers", types.StringValue("A"), types.Int32Unknown()},
+		{"unknown priority defers", types.StringValue("MX"), types.Int32Unknown()},
 	}
 
 	for _, testCase := range cases {
@@ -799,10 +802,10 @@ func TestRecordValidateConfig_DefersOnUnknown(t *testing.T) {
 			res := &recordResource{}
 			req := resource.ValidateConfigRequest{
 				Config: recordConfig(t, recordModel{
-					DomainName: types.StringValue("example.com"),
-					Host:       types.StringValue(""),
-					RecordType: testCase.recordType,
-					Answer:     types.StringValue("mail.example.com"),
+					DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+					Host:       hostnameValue{StringValue: types.StringValue("")},
+					RecordType: recordTypeValue{StringValue: testCase.recordType},
+					Answer:     answerValue{StringValue: types.StringValue("mail.example.com")},
 					Priority:   testCase.priority,
 				}),
 			}
@@ -861,3 +864,354 @@ func recordState(t *testing.T, model recordModel) tfsdk.State {
 
 	return state
 }
+
+// TestRecordValidateConfig_Answer pins the per-type answer rules, so a record
+// Name.com would refuse fails at plan time with a diagnostic on answer.
+func TestRecordValidateConfig_Answer(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name       string
+		recordType string
+		answer     types.String
+		wantErr    bool
+	}{
+		{"A with IPv4", "A", types.StringValue("192.0.2.1"), false},
+		{"A with IPv6", "A", types.StringValue("2001:db8::1"), true},
+		{"A with a name", "a", types.StringValue("example.com"), true},
+		{"AAAA with IPv6", "AAAA", types.StringValue("2001:DB8:0::1"), false},
+		{"AAAA with IPv4", "AAAA", types.StringValue("192.0.2.1"), true},
+		{"AAAA with mapped IPv4", "AAAA", types.StringValue("::ffff:192.0.2.1"), true},
+		{"CNAME with a name", "CNAME", types.StringValue("bar.com."), false},
+		{"CNAME with an IP", "CNAME", types.StringValue("192.0.2.1"), true},
+		{"ANAME with a name", "ANAME", types.StringValue("lb.example.net"), false},
+		{"NS with a bad label", "NS", types.StringValue("ns1..example.com"), true},
+		{"MX with a name", "MX", types.StringValue("mail.example.com"), false},
+		{"MX with an IP", "MX", types.StringValue("192.0.2.25"), true},
+		{"MX with a space", "MX", types.StringValue("mail example.com"), true},
+		{"SRV with weight port target", "SRV", types.StringValue("5 5060 sip.example.com"), false},
+		{"SRV with no target", "SRV", types.StringValue("0 0 ."), false},
+		{"SRV with priority in the answer", "SRV", types.StringValue("10 5 5060 sip.example.com"), true},
+		{"SRV with a port out of range", "SRV", types.StringValue("5 70000 sip.example.com"), true},
+		{"SRV with an IP target", "SRV", types.StringValue("5 5060 192.0.2.1"), true},
+		{"TXT at the limit", "TXT", types.StringValue(strings.Repeat("a", txtMaxLength)), false},
+		{"TXT over the limit", "TXT", types.StringValue(strings.Repeat("a", txtMaxLength+1)), true},
+		{"unknown answer defers", "A", types.StringUnknown(), false},
+		{"null answer is skipped", "A", types.StringNull(), false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			res := &recordResource{}
+			req := resource.ValidateConfigRequest{
+				Config: recordConfig(t, recordModel{
+					DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+					Host:       hostnameValue{StringValue: types.StringValue("www")},
+					RecordType: recordTypeValue{StringValue: types.StringValue(testCase.recordType)},
+					Answer:     answerValue{StringValue: testCase.answer},
+					Priority:   types.Int32Null(),
+				}),
+			}
+			resp := &resource.ValidateConfigResponse{}
+
+			res.ValidateConfig(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != testCase.wantErr {
+				t.Fatalf("ValidateConfig error = %v, want %v (diags: %v)", resp.Diagnostics.HasError(), testCase.wantErr, resp.Diagnostics)
+			}
+
+			if testCase.wantErr {
+				withPath, ok := resp.Diagnostics[0].(diag.DiagnosticWithPath)
+				if !ok || !withPath.Path().Equal(path.Root(keyAnswer)) {
+					t.Errorf("diagnostic is not attached to the answer attribute: %v", resp.Diagnostics[0])
+				}
+			}
+		})
+	}
+}
+
+// recordsServer serves ListRecords for example.com from the given JSON records
+// array and counts the calls. A nil count argument is allowed.
+func recordsServer(t *testing.T, records string, calls *int) *namecom.NameCom {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com/records", func(writer http.ResponseWriter, _ *http.Request) {
+		if calls != nil {
+			*calls++
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"records":%s}`, records)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return namecom.Mock("u", "t", server.URL)
+}
+
+// modifyRecordPlan runs ModifyPlan for a planned record, with prior state when
+// state is not nil, and returns the diagnostics.
+func modifyRecordPlan(t *testing.T, res *recordResource, state *recordModel, plan recordModel) diag.Diagnostics {
+	t.Helper()
+
+	req := resource.ModifyPlanRequest{Plan: recordPlan(t, plan)}
+	if state != nil {
+		req.State = recordState(t, *state)
+	}
+
+	resp := resource.ModifyPlanResponse{Plan: req.Plan}
+
+	res.ModifyPlan(context.Background(), req, &resp)
+
+	return resp.Diagnostics
+}
+
+// plannedRecord is a planned (not yet created) record in example.com.
+func plannedRecord(host, recordType, answer string) recordModel {
+	return recordModel{
+		ID:         types.StringUnknown(),
+		RecordID:   types.Int32Unknown(),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Host:       hostnameValue{StringValue: types.StringValue(host)},
+		RecordType: recordTypeValue{StringValue: types.StringValue(recordType)},
+		Answer:     answerValue{StringValue: types.StringValue(answer)},
+		Priority:   types.Int32Null(),
+	}
+}
+
+// TestRecordModifyPlan_CNAMEAtApex rejects a CNAME at the zone apex, for both
+// apex spellings, without needing the API.
+func TestRecordModifyPlan_CNAMEAtApex(t *testing.T) {
+	t.Parallel()
+
+	for _, host := range []string{"", "@"} {
+		diags := modifyRecordPlan(t, &recordResource{}, nil, plannedRecord(host, "cname", "bar.com"))
+
+		if !diags.HasError() {
+			t.Fatalf("host %q: expected an apex CNAME error", host)
+		}
+
+		withPath, ok := diags[0].(diag.DiagnosticWithPath)
+		if !ok || !withPath.Path().Equal(path.Root(keyRecordType)) {
+			t.Errorf("host %q: diagnostic is not attached to record_type: %v", host, diags[0])
+		}
+
+		if !strings.Contains(diags[0].Detail(), "ANAME") {
+			t.Errorf("host %q: diagnostic should suggest ANAME: %s", host, diags[0].Detail())
+		}
+	}
+}
+
+// TestRecordModifyPlan_CNAMECoexistence covers both directions of the
+// coexistence rule, reported as a warning because the conflicting record may
+// be destroyed by the same plan, and that the record being updated does not
+// conflict with itself.
+func TestRecordModifyPlan_CNAMECoexistence(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	existing := `[{"id":7,"domainName":"example.com","host":"www","type":"A","answer":"192.0.2.1"},` +
+		`{"id":8,"domainName":"example.com","host":"docs","type":"CNAME","answer":"pages.example.net"}]`
+
+	self := plannedRecord("www", "A", "192.0.2.1")
+	self.ID = types.StringValue("7")
+	self.RecordID = types.Int32Value(7)
+
+	cases := []struct {
+		name     string
+		state    *recordModel
+		plan     recordModel
+		wantWarn bool
+	}{
+		{"CNAME on a host with an A record", nil, plannedRecord("WWW", "CNAME", "bar.com"), true},
+		{"TXT on a host with a CNAME", nil, plannedRecord("docs", "TXT", "hello"), true},
+		{"CNAME on a free host", nil, plannedRecord("blog", "CNAME", "bar.com"), false},
+		{"A next to an A", nil, plannedRecord("www", "A", "192.0.2.2"), false},
+		{"changing its own type to CNAME", &self, plannedRecord("www", "CNAME", "bar.com"), false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := &recordResource{client: recordsServer(t, existing, nil), records: newRecordCache(true)}
+
+			diags := modifyRecordPlan(t, res, testCase.state, testCase.plan)
+
+			if diags.HasError() || (diags.WarningsCount() == 1) != testCase.wantWarn {
+				t.Fatalf("ModifyPlan warnings = %d, want a conflict warning = %v (diags: %v)", diags.WarningsCount(), testCase.wantWarn, diags)
+			}
+		})
+	}
+}
+
+// TestRecordModifyPlan_AdoptExisting confirms the record adopt_existing would
+// take over is not reported as a CNAME conflict, while a CNAME that does not
+// match it still is.
+func TestRecordModifyPlan_AdoptExisting(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	existing := `[{"id":8,"domainName":"example.com","host":"docs","type":"CNAME","answer":"pages.example.net"}]`
+
+	cases := []struct {
+		name     string
+		adopt    bool
+		answer   string
+		wantWarn bool
+	}{
+		{"adopting the identical CNAME", true, "pages.example.net.", false},
+		{"adopting with a different target", true, "other.example.net", true},
+		{"identical CNAME without adopt_existing", false, "pages.example.net", true},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res := &recordResource{client: recordsServer(t, existing, nil), records: newRecordCache(true)}
+
+			plan := plannedRecord("docs", "CNAME", testCase.answer)
+			plan.AdoptExisting = types.BoolValue(testCase.adopt)
+
+			diags := modifyRecordPlan(t, res, nil, plan)
+
+			if diags.HasError() || (diags.WarningsCount() == 1) != testCase.wantWarn {
+				t.Fatalf("ModifyPlan warnings = %d, want a conflict warning = %v (diags: %v)", diags.WarningsCount(), testCase.wantWarn, diags)
+			}
+		})
+	}
+}
+
+// TestRecordModifyPlan_CachesPerDomain confirms records are listed once per
+// domain across plans, skipped for records whose slot is unchanged, and listed
+// again after a write invalidates the domain.
+func TestRecordModifyPlan_CachesPerDomain(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	calls := 0
+	res := &recordResource{client: recordsServer(t, `[]`, &calls), records: newRecordCache(true)}
+
+	modifyRecordPlan(t, res, nil, plannedRecord("a", "CNAME", "bar.com"))
+	modifyRecordPlan(t, res, nil, plannedRecord("b", "CNAME", "bar.com"))
+
+	unchanged := plannedRecord("c", "CNAME", "bar.com")
+	unchanged.ID = types.StringValue("9")
+	modifyRecordPlan(t, res, &unchanged, unchanged)
+
+	if calls != 1 {
+		t.Fatalf("ListRecords calls = %d, want 1", calls)
+	}
+
+	res.invalidate("Example.com.")
+	modifyRecordPlan(t, res, nil, plannedRecord("d", "CNAME", "bar.com"))
+
+	if calls != 2 {
+		t.Errorf("ListRecords calls after invalidation = %d, want 2", calls)
+	}
+}
+
+// TestRecordModifyPlan_ListFailureWarns keeps the plan going when the records
+// cannot be listed: the API still enforces the rule at apply time.
+func TestRecordModifyPlan_ListFailureWarns(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(writer, `{"message":"boom"}`)
+	}))
+	t.Cleanup(server.Close)
+
+	res := &recordResource{client: namecom.Mock("u", "t", server.URL), records: newRecordCache(true)}
+
+	diags := modifyRecordPlan(t, res, nil, plannedRecord("www", "CNAME", "bar.com"))
+
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("expected a single warning, got %v", diags)
+	}
+}
+
+// TestRecordCreate_AdoptExisting pins adopt_existing: a matching record is
+// taken over instead of duplicated (updating a differing priority), while no
+// match, or the option unset, creates a new record.
+func TestRecordCreate_AdoptExisting(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	existing := `[{"id":7,"domainName":"example.com","host":"","type":"MX","answer":"mail.example.com.","priority":20},` +
+		`{"id":8,"domainName":"example.com","host":"www","type":"A","answer":"192.0.2.1"}]`
+
+	cases := []struct {
+		name       string
+		adopt      types.Bool
+		plan       recordModel
+		priority   types.Int32
+		wantID     string
+		wantMethod string
+	}{
+		{"adopts a matching record", types.BoolValue(true), plannedRecord("WWW", "a", "192.0.2.1"), types.Int32Null(), "8", ""},
+		{
+			"adopts at the apex and fixes priority", types.BoolValue(true),
+			plannedRecord("", "MX", "mail.example.com"), types.Int32Value(10), "7", http.MethodPut,
+		},
+		{"creates when nothing matches", types.BoolValue(true), plannedRecord("www", "A", "192.0.2.2"), types.Int32Null(), "99", http.MethodPost},
+		{"creates when not opted in", types.BoolNull(), plannedRecord("www", "A", "192.0.2.1"), types.Int32Null(), "99", http.MethodPost},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var writes []string
+
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v4/domains/example.com/records", func(writer http.ResponseWriter, req *http.Request) {
+				writer.Header().Set("Content-Type", "application/json")
+
+				if req.Method == http.MethodGet {
+					fmt.Fprintf(writer, `{"records":%s}`, existing)
+
+					return
+				}
+
+				writes = append(writes, req.Method)
+				fmt.Fprint(writer, `{"id":99,"domainName":"example.com","host":"www","type":"A","answer":"192.0.2.2"}`)
+			})
+			mux.HandleFunc("/v4/domains/example.com/records/7", func(writer http.ResponseWriter, req *http.Request) {
+				writes = append(writes, req.Method)
+				writer.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(writer, `{"id":7,"domainName":"example.com","host":"","type":"MX","answer":"mail.example.com","priority":10}`)
+			})
+
+			server := httptest.NewServer(mux)
+			t.Cleanup(server.Close)
+
+			res := &recordResource{client: namecom.Mock("u", "t", server.URL)}
+
+			plan := testCase.plan
+			plan.AdoptExisting = testCase.adopt
+			plan.Priority = testCase.priority
+
+			req := resource.CreateRequest{Plan: recordPlan(t, plan)}
+			resp := resource.CreateResponse{State: recordState(t, recordModel{})}
+
+			res.Create(context.Background(), req, &resp)
+
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+
+			var got recordModel
+
+			resp.State.Get(context.Background(), &got)
+
+			if got.ID.ValueString() != testCase.wantID {
+				t.Errorf("id = %q, want %q", got.ID.ValueString(), testCase.wantID)
+			}
+
+			wantWrites := 0
+			if testCase.wantMethod != "" {
+				wantWrites = 1
+			}
+
+			if len(writes) != wantWrites || (wantWrites == 1 && writes[0] != testCase.wantMethod) {
+				t.Errorf("writes = %v, want %q", writes, testCase.wantMethod)
+			}
+		})
+	}
+}
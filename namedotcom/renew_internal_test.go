@@ -0,0 +1,113 @@
+//nolint:paralleltest // The renewal tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// invokeRenew runs the renew action for example.com, renewing at 12.99 USD a
+// year, and returns the response, the RenewDomain request and the progress
+// messages.
+func invokeRenew(t *testing.T, years types.Int32, maxPrice float64) (action.InvokeResponse, *namecom.RenewDomainRequest, []string) {
+	t.Helper()
+
+	var renewal *namecom.RenewDomainRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domainName":"example.com","renewalPrice":12.99,"expireDate":"2027-01-01T00:00:00Z"}`)
+	})
+	mux.HandleFunc("/v4/domains/example.com:renew", func(writer http.ResponseWriter, request *http.Request) {
+		renewal = &namecom.RenewDomainRequest{}
+		json.NewDecoder(request.Body).Decode(renewal)
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domain":{"domainName":"example.com","expireDate":"2029-01-01T00:00:00Z"},"order":77,"totalPaid":25.98}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	act := &renewDomainAction{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp action.SchemaResponse
+
+	act.Schema(context.Background(), action.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	config.Set(context.Background(), &renewDomainModel{
+		DomainName: types.StringValue("example.com"),
+		Years:      years,
+		MaxPrice:   types.Float64Value(maxPrice),
+	})
+
+	var progress []string
+
+	resp := action.InvokeResponse{SendProgress: func(event action.InvokeProgressEvent) {
+		progress = append(progress, event.Message)
+	}}
+
+	act.Invoke(context.Background(), action.InvokeRequest{Config: tfsdk.Config(config)}, &resp)
+
+	return resp, renewal, progress
+}
+
+// TestRenewDomainInvoke confirms the renewal is bought at the renewal price
+// times years, and the order and new expiry date are reported.
+func TestRenewDomainInvoke(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	resp, renewal, progress := invokeRenew(t, types.Int32Value(2), 30)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if renewal == nil || renewal.PurchasePrice != 25.98 || renewal.Years != 2 {
+		t.Fatalf("RenewDomain request = %+v, want 25.98 USD for 2 years", renewal)
+	}
+
+	last := progress[len(progress)-1]
+	if !strings.Contains(last, "order 77") || !strings.Contains(last, "2029-01-01") {
+		t.Errorf("progress = %q, want the order ID and new expiry date", last)
+	}
+}
+
+// TestRenewDomainInvoke_Guard confirms nothing is renewed above max_price,
+// with years defaulting to 1.
+func TestRenewDomainInvoke_Guard(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	resp, renewal, _ := invokeRenew(t, types.Int32Null(), 10)
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error above max_price")
+	}
+
+	withPath, ok := resp.Diagnostics[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root(keyMaxPrice)) {
+		t.Errorf("diagnostic not attached to max_price: %v", resp.Diagnostics[0])
+	}
+
+	if !strings.Contains(resp.Diagnostics[0].Detail(), "12.99 USD") {
+		t.Errorf("detail = %q, want the 1-year price", resp.Diagnostics[0].Detail())
+	}
+
+	if renewal != nil {
+		t.Error("RenewDomain must not be called above max_price")
+	}
+}
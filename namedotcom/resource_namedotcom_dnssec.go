@@ -11,15 +11,17 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/namedotcom/go/v4/namecom"
 )
 
 // Ensure the resource satisfies the required framework interfaces.
 var (
-	_ resource.Resource                = (*dnssecResource)(nil)
-	_ resource.ResourceWithConfigure   = (*dnssecResource)(nil)
-	_ resource.ResourceWithImportState = (*dnssecResource)(nil)
+	_ resource.Resource                   = (*dnssecResource)(nil)
+	_ resource.ResourceWithConfigure      = (*dnssecResource)(nil)
+	_ resource.ResourceWithImportState    = (*dnssecResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dnssecResource)(nil)
 )
 
 // dnssecResource manages DNSSEC settings for a domain.
@@ -29,12 +31,12 @@ type dnssecResource struct {
 
 // dnssecModel maps the DNSSEC schema to a Go struct.
 type dnssecModel struct {
-	ID         types.String `tfsdk:"id"`
-	DomainName types.String `tfsdk:"domain_name"`
-	KeyTag     types.Int32  `tfsdk:"key_tag"`
-	Algorithm  types.Int32  `tfsdk:"algorithm"`
-	DigestType types.Int32  `tfsdk:"digest_type"`
-	Digest     types.String `tfsdk:"digest"`
+	ID         types.String  `tfsdk:"id"`
+	DomainName hostnameValue `tfsdk:"domain_name"`
+	KeyTag     types.Int32   `tfsdk:"key_tag"`
+	Algorithm  types.Int32   `tfsdk:"algorithm"`
+	DigestType types.Int32   `tfsdk:"digest_type"`
+	Digest     digestValue   `tfsdk:"digest"`
 }
 
 // NewDNSSECResource is the resource factory registered with the provider.
@@ -57,8 +59,9 @@ func (r *dnssecResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description:   descIDIsDomainName,
 			},
 			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
 				Required:      true,
-				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange()},
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
 				Description:   "DomainName is the zone that the DNSSEC belongs to. Changing this forces a new resource.",
 			},
 			keyKeyTag: schema.Int32Attribute{
@@ -68,17 +71,21 @@ func (r *dnssecResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 			},
 			keyAlgorithm: schema.Int32Attribute{
 				Required:      true,
+				Validators:    []validator.Int32{algorithmValidator()},
 				PlanModifiers: []planmodifier.Int32{int32planmodifier.RequiresReplace()},
 				Description:   "Algorithm is an integer identifying the algorithm used for signing. Changing this forces a new resource.",
 			},
 			keyDigestType: schema.Int32Attribute{
 				Required:      true,
+				Validators:    []validator.Int32{digestTypeValidator()},
 				PlanModifiers: []planmodifier.Int32{int32planmodifier.RequiresReplace()},
 				Description:   "DigestType is an integer identifying the algorithm used to create the digest. Changing this forces a new resource.",
 			},
 			keyDigest: schema.StringAttribute{
+				CustomType:    digestType{},
 				Required:      true,
-				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange()},
+				Validators:    []validator.String{digestValidator{}},
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[digestSemantics]()},
 				Description:   "Digest is a digest of the DNSKEY RR that is registered with the registry. Changing this forces a new resource.",
 			},
 		},
@@ -94,6 +101,27 @@ func (r *dnssecResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ValidateConfig rejects a digest whose length does not match its digest type,
+// which the registry would otherwise only refuse at apply.
+func (r *dnssecResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var (
+		digestTypeValue types.Int32
+		digest          digestValue
+	)
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyDigestType), &digestTypeValue)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyDigest), &digest)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := checkDigestLength(digestTypeValue, digest.StringValue)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root(keyDigest), "Digest does not match digest_type", err.Error()+".")
+	}
+}
+
 func (r *dnssecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan dnssecModel
 
@@ -116,7 +144,7 @@ func (r *dnssecResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	plan.ID = plan.DomainName
+	plan.ID = plan.DomainName.StringValue
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
@@ -149,7 +177,7 @@ func (r *dnssecResource) Read(ctx context.Context, req resource.ReadRequest, res
 	// adopted from the response — a canonicalization difference (e.g. digest
 	// case) must not force a spurious replacement on refresh. Only the numeric
 	// fields are refreshed; they are populated from the API on import.
-	state.ID = state.DomainName
+	state.ID = state.DomainName.StringValue
 	state.KeyTag = types.Int32Value(dnssec.KeyTag)
 	state.Algorithm = types.Int32Value(dnssec.Algorithm)
 	state.DigestType = types.Int32Value(dnssec.DigestType)
@@ -214,7 +242,8 @@ func resourceDNSSECImporterParseID(importID string) (domainName, digest string,
 	return importID[:idx], importID[idx+1:], nil
 }
 
-// createDNSSECAPI registers a DNSSEC key via the Name.com API.
+// createDNSSECAPI registers a DNSSEC key via the Name.com API. Like the other
+// DNSSEC helpers it sends the digest without whitespace.
 func createDNSSECAPI(
 	ctx context.Context,
 	client *namecom.NameCom,
@@ -232,7 +261,7 @@ func createDNSSECAPI(
 		KeyTag:     keyTag,
 		Algorithm:  algorithm,
 		DigestType: digestType,
-		Digest:     digest,
+		Digest:     compactDigest(digest),
 	})
 	if err != nil {
 		return errors.Wrap(err, "Error CreateDNSSEC")
@@ -250,7 +279,7 @@ func readDNSSECAPI(ctx context.Context, client *namecom.NameCom, domainName, dig
 
 	dnssec, err := client.GetDNSSEC(&namecom.GetDNSSECRequest{
 		DomainName: domainName,
-		Digest:     digest,
+		Digest:     compactDigest(digest),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, "Error GetDNSSEC")
@@ -268,7 +297,7 @@ func deleteDNSSECAPI(ctx context.Context, client *namecom.NameCom, domainName, d
 
 	_, err = client.DeleteDNSSEC(&namecom.DeleteDNSSECRequest{
 		DomainName: domainName,
-		Digest:     digest,
+		Digest:     compactDigest(digest),
 	})
 	if err != nil {
 		return errors.Wrap(err, "Error DeleteDNSSEC")
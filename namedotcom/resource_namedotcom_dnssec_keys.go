@@ -0,0 +1,440 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the resource satisfies the required framework interfaces.
+var (
+	_ resource.Resource                   = (*dnssecKeysResource)(nil)
+	_ resource.ResourceWithConfigure      = (*dnssecKeysResource)(nil)
+	_ resource.ResourceWithImportState    = (*dnssecKeysResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*dnssecKeysResource)(nil)
+)
+
+// dnssecKeysResource manages the whole set of DS records of a domain, so keys
+// can be rolled over without a window where none is registered.
+type dnssecKeysResource struct {
+	client *namecom.NameCom
+}
+
+// dnssecKeysModel maps the DNSSEC keys schema to a Go struct.
+type dnssecKeysModel struct {
+	ID              types.String  `tfsdk:"id"`
+	DomainName      hostnameValue `tfsdk:"domain_name"`
+	Keys            []dsKeyModel  `tfsdk:"keys"`
+	RemoveUnmanaged types.Bool    `tfsdk:"remove_unmanaged"`
+}
+
+// dsKeyModel maps one DS record of the keys set to a Go struct.
+type dsKeyModel struct {
+	KeyTag     types.Int32 `tfsdk:"key_tag"`
+	Algorithm  types.Int32 `tfsdk:"algorithm"`
+	DigestType types.Int32 `tfsdk:"digest_type"`
+	Digest     digestValue `tfsdk:"digest"`
+}
+
+// NewDNSSECKeysResource is the resource factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the resource.Resource interface.
+func NewDNSSECKeysResource() resource.Resource {
+	return &dnssecKeysResource{}
+}
+
+func (r *dnssecKeysResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dnssec_keys"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (r *dnssecKeysResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Manages the full set of DS records of a domain. New keys are registered before retired ones are removed, so a key rollover never leaves the domain without a valid DS record.",
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
+				Description:   "DomainName is the zone that the DS records belong to. Changing this forces a new resource.",
+			},
+			keyKeys: schema.SetNestedAttribute{
+				Required:   true,
+				Validators: []validator.Set{setvalidator.SizeAtLeast(1)},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						keyKeyTag: schema.Int32Attribute{
+							Required:    true,
+							Description: "KeyTag contains the key tag value of the DNSKEY RR that validates this signature.",
+						},
+						keyAlgorithm: schema.Int32Attribute{
+							Required:    true,
+							Validators:  []validator.Int32{algorithmValidator()},
+							Description: "Algorithm is an integer identifying the algorithm used for signing.",
+						},
+						keyDigestType: schema.Int32Attribute{
+							Required:    true,
+							Validators:  []validator.Int32{digestTypeValidator()},
+							Description: "DigestType is an integer identifying the algorithm used to create the digest.",
+						},
+						keyDigest: schema.StringAttribute{
+							CustomType:  digestType{},
+							Required:    true,
+							Validators:  []validator.String{digestValidator{}},
+							Description: "Digest is a digest of the DNSKEY RR that is registered with the registry. It identifies the key, so two keys cannot share a digest.",
+						},
+					},
+				},
+				Description: "Keys is the desired set of DS records. Keys added to the set are registered before keys removed from it are deleted.",
+			},
+			keyRemoveUnmanaged: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "RemoveUnmanaged deletes DS records registered outside this resource, making keys the exact set at Name.com. Defaults to false, which leaves such records alone.",
+			},
+		},
+	}
+}
+
+func (r *dnssecKeysResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects keys whose digest length does not match their digest
+// type, which the registry would otherwise only refuse at apply, and keys
+// sharing a digest. The digest identifies a key, so two set elements that
+// differ only in the digest's case or whitespace, or in other attributes,
+// would map to the same DS record.
+func (r *dnssecKeysResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var keys types.Set
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyKeys), &keys)...)
+
+	if resp.Diagnostics.HasError() || keys.IsNull() || keys.IsUnknown() {
+		return
+	}
+
+	seen := make(map[string]string, len(keys.Elements()))
+
+	for _, element := range keys.Elements() {
+		var key dsKeyModel
+
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+
+		resp.Diagnostics.Append(object.As(ctx, &key, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := checkDigestLength(key.DigestType, key.Digest.StringValue)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(keyKeys).AtSetValue(element).AtName(keyDigest),
+				"Digest does not match digest_type",
+				err.Error()+".",
+			)
+		}
+
+		if key.Digest.IsNull() || key.Digest.IsUnknown() {
+			continue
+		}
+
+		digest := digestKey(key.Digest.ValueString())
+		if first, exists := seen[digest]; exists {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(keyKeys).AtSetValue(element).AtName(keyDigest),
+				"Duplicate digest",
+				fmt.Sprintf("%q and %q are the same digest; each key must have its own.", first, key.Digest.ValueString()),
+			)
+
+			continue
+		}
+
+		seen[digest] = key.Digest.ValueString()
+	}
+}
+
+func (r *dnssecKeysResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dnssecKeysModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &plan, nil, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.DomainName.StringValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Read refreshes the keys in state from Name.com. Keys that were deleted
+// outside Terraform drop out of state so the next plan registers them again.
+// Unmanaged keys are added to state only when remove_unmanaged is set, so the
+// plan shows their removal; after an import every registered key is adopted.
+func (r *dnssecKeysResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dnssecKeysModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	registered, err := listDNSSECsAPI(ctx, r.client, state.DomainName.ValueString())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Error listing DNSSEC keys", err.Error())
+
+		return
+	}
+
+	adoptAll := state.Keys == nil || state.RemoveUnmanaged.ValueBool()
+	managed := keysByDigest(state.Keys)
+
+	keys := []dsKeyModel{}
+
+	for _, dnssec := range registered {
+		prior, ok := managed[digestKey(dnssec.Digest)]
+		if !ok && !adoptAll {
+			continue
+		}
+
+		key := dsKeyModelFrom(dnssec)
+		if ok {
+			// The configured digest is kept so a case difference is not drift.
+			key.Digest = prior.Digest
+		}
+
+		keys = append(keys, key)
+	}
+
+	state.ID = state.DomainName.StringValue
+	state.Keys = keys
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *dnssecKeysResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dnssecKeysModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.reconcile(ctx, &plan, state.Keys, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = plan.DomainName.StringValue
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dnssecKeysResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dnssecKeysModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, key := range state.Keys {
+		err := deleteDNSSECAPI(ctx, r.client, state.DomainName.ValueString(), key.Digest.ValueString())
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("Error deleting DNSSEC key", err.Error())
+
+			return
+		}
+	}
+}
+
+// ImportState imports the DS records of a domain by domain name. The keys are
+// left null, which makes the following Read adopt every registered key.
+func (r *dnssecKeysResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyDomainName), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyRemoveUnmanaged), false)...)
+}
+
+// reconcile makes the DS records of the domain match plan. Missing keys are
+// registered first; only then are retired keys deleted, where a key is retired
+// when prior has it and plan does not, or when remove_unmanaged is set and plan
+// does not have it. A registered key whose digest matches a planned key but
+// whose other fields differ is deleted and registered again last, since
+// Name.com identifies keys by digest alone.
+func (r *dnssecKeysResource) reconcile(ctx context.Context, plan *dnssecKeysModel, prior []dsKeyModel, diags *diag.Diagnostics) {
+	domainName := plan.DomainName.ValueString()
+
+	registered, err := listDNSSECsAPI(ctx, r.client, domainName)
+	if err != nil {
+		diags.AddError("Error listing DNSSEC keys", err.Error())
+
+		return
+	}
+
+	current := make(map[string]*namecom.DNSSEC, len(registered))
+	for _, dnssec := range registered {
+		current[digestKey(dnssec.Digest)] = dnssec
+	}
+
+	desired := keysByDigest(plan.Keys)
+	previous := keysByDigest(prior)
+
+	var changed []dsKeyModel
+
+	for _, key := range plan.Keys {
+		dnssec, ok := current[digestKey(key.Digest.ValueString())]
+		if ok {
+			if dsKeyModelFrom(dnssec).sameKey(key) {
+				continue
+			}
+
+			changed = append(changed, key)
+
+			continue
+		}
+
+		err = createDSKeyAPI(ctx, r.client, domainName, key)
+		if err != nil {
+			diags.AddError("Error creating DNSSEC key", err.Error())
+
+			return
+		}
+	}
+
+	for digest, dnssec := range current {
+		_, wanted := desired[digest]
+		_, managed := previous[digest]
+
+		if wanted || (!managed && !plan.RemoveUnmanaged.ValueBool()) {
+			continue
+		}
+
+		err = deleteDNSSECAPI(ctx, r.client, domainName, dnssec.Digest)
+		if err != nil && !isNotFoundError(err) {
+			diags.AddError("Error deleting DNSSEC key", err.Error())
+
+			return
+		}
+	}
+
+	for _, key := range changed {
+		err = deleteDNSSECAPI(ctx, r.client, domainName, current[digestKey(key.Digest.ValueString())].Digest)
+		if err != nil && !isNotFoundError(err) {
+			diags.AddError("Error deleting DNSSEC key", err.Error())
+
+			return
+		}
+
+		err = createDSKeyAPI(ctx, r.client, domainName, key)
+		if err != nil {
+			diags.AddError("Error creating DNSSEC key", err.Error())
+
+			return
+		}
+	}
+}
+
+// sameKey reports whether two keys with the same digest also agree on the
+// key tag, algorithm and digest type.
+func (k dsKeyModel) sameKey(other dsKeyModel) bool {
+	return k.KeyTag.Equal(other.KeyTag) && k.Algorithm.Equal(other.Algorithm) && k.DigestType.Equal(other.DigestType)
+}
+
+// dsKeyModelFrom converts a DS record returned by the API into its model.
+func dsKeyModelFrom(dnssec *namecom.DNSSEC) dsKeyModel {
+	return dsKeyModel{
+		KeyTag:     types.Int32Value(dnssec.KeyTag),
+		Algorithm:  types.Int32Value(dnssec.Algorithm),
+		DigestType: types.Int32Value(dnssec.DigestType),
+		Digest:     dnsStringOf[digestSemantics](dnssec.Digest),
+	}
+}
+
+// keysByDigest indexes keys by their normalized digest.
+func keysByDigest(keys []dsKeyModel) map[string]dsKeyModel {
+	indexed := make(map[string]dsKeyModel, len(keys))
+	for _, key := range keys {
+		indexed[digestKey(key.Digest.ValueString())] = key
+	}
+
+	return indexed
+}
+
+// digestKey normalizes a digest the way digestSemantics compares them:
+// upper-case with whitespace removed.
+func digestKey(digest string) string {
+	return strings.ToUpper(compactDigest(digest))
+}
+
+// createDSKeyAPI registers one key of the set via the Name.com API.
+func createDSKeyAPI(ctx context.Context, client *namecom.NameCom, domainName string, key dsKeyModel) error {
+	return createDNSSECAPI(
+		ctx, client,
+		domainName,
+		key.KeyTag.ValueInt32(),
+		key.Algorithm.ValueInt32(),
+		key.DigestType.ValueInt32(),
+		key.Digest.ValueString(),
+	)
+}
+
+// listDNSSECsAPI fetches every DS record of a domain via the Name.com API.
+func listDNSSECsAPI(ctx context.Context, client *namecom.NameCom, domainName string) ([]*namecom.DNSSEC, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "rate limiting error")
+	}
+
+	resp, err := client.ListDNSSECs(&namecom.ListDNSSECsRequest{DomainName: domainName})
+	if err != nil {
+		return nil, errors.Wrap(err, "Error ListDNSSECs")
+	}
+
+	return resp.Dnssec, nil
+}
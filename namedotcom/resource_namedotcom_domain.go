@@ -0,0 +1,713 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"slices"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the resource satisfies the required framework interfaces.
+var (
+	_ resource.Resource                   = (*domainResource)(nil)
+	_ resource.ResourceWithConfigure      = (*domainResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*domainResource)(nil)
+	_ resource.ResourceWithImportState    = (*domainResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*domainResource)(nil)
+)
+
+// Registration periods Name.com accepts, in years.
+const (
+	registrationYearsMin = 1
+	registrationYearsMax = 10
+)
+
+// purchaseTypeRegistration is the purchase type of a regular registration,
+// which Name.com assumes when none is passed to CreateDomain.
+const purchaseTypeRegistration = "registration"
+
+// domainResource registers a domain. Domains cannot be deleted, so destroying
+// the resource only removes it from state.
+type domainResource struct {
+	client *namecom.NameCom
+}
+
+// domainModel maps the domain schema to a Go struct.
+type domainModel struct {
+	ID               types.String  `tfsdk:"id"`
+	DomainName       hostnameValue `tfsdk:"domain_name"`
+	Years            types.Int32   `tfsdk:"years"`
+	MaxPurchasePrice types.Float64 `tfsdk:"max_purchase_price"`
+	TLDRequirements  types.Map     `tfsdk:"tld_requirements"`
+	Nameservers      types.Set     `tfsdk:"nameservers"`
+	Contacts         types.Object  `tfsdk:"contacts"`
+	PrivacyEnabled   types.Bool    `tfsdk:"privacy_enabled"`
+	AutorenewEnabled types.Bool    `tfsdk:"autorenew_enabled"`
+	Locked           types.Bool    `tfsdk:"locked"`
+	PurchasePrice    types.Float64 `tfsdk:"purchase_price"`
+	PurchaseType     types.String  `tfsdk:"purchase_type"`
+	Premium          types.Bool    `tfsdk:"premium"`
+	RenewalPrice     types.Float64 `tfsdk:"renewal_price"`
+	OrderID          types.Int32   `tfsdk:"order_id"`
+	CreateDate       types.String  `tfsdk:"create_date"`
+	ExpireDate       types.String  `tfsdk:"expire_date"`
+}
+
+// NewDomainResource is the resource factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the resource.Resource interface.
+func NewDomainResource() resource.Resource {
+	return &domainResource{}
+}
+
+func (r *domainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+//nolint:lll,funlen // Attribute descriptions are intentionally verbose for the registry docs; the schema is a single declaration.
+func (r *domainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Registers a domain with Name.com. Domains cannot be deleted, so destroying this resource only removes it from state; the domain stays registered until it expires.",
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
+				Description:   "DomainName is the punycode encoded value of the domain name to register. Changing this forces a new resource.",
+			},
+			keyYears: schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(registrationYearsMin),
+				Validators:  []validator.Int32{int32validator.Between(registrationYearsMin, registrationYearsMax)},
+				Description: "Years is the registration period, 1-10 years. Defaults to 1. Only used when the domain is registered.",
+			},
+			keyMaxPurchasePrice: schema.Float64Attribute{
+				Required:    true,
+				Description: "MaxPurchasePrice is the most, in USD, that registering the domain for the given years may cost. The price is checked at plan time and again before purchasing; a higher price fails instead of buying the domain. Whois privacy bought with privacy_enabled is charged on top.",
+			},
+			keyTLDRequirements: schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "TLDRequirements passes the additional data some registries require. Only used when the domain is registered.",
+			},
+			keyNameservers: schema.SetAttribute{
+				Optional:      true,
+				Computed:      true,
+				ElementType:   hostnameType{},
+				PlanModifiers: []planmodifier.Set{setplanmodifier.UseStateForUnknown()},
+				Description:   "Nameservers to register the domain with. If unspecified the account default nameservers are used. Only used when the domain is registered; manage them afterwards with namedotcom_domain_nameservers.",
+			},
+			keyContacts: schema.SingleNestedAttribute{
+				Optional:    true,
+				Attributes:  contactsAttributes(),
+				Description: "Contacts to register the domain with, per role. Roles left out default to the registrant, as in namedotcom_domain_contacts; leave out the registrant too, or contacts altogether, to use the account default contacts. Only used when the domain is registered; manage them afterwards with namedotcom_domain_contacts.",
+			},
+			keyPrivacyEnabled: schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "PrivacyEnabled buys Whois privacy together with the domain, at an additional cost. Only used when the domain is registered; manage it afterwards with namedotcom_whois_privacy.",
+			},
+			keyAutorenewEnabled: schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "AutorenewEnabled sets whether the domain renews automatically before it expires. Only used when the domain is registered; manage it afterwards with namedotcom_domain_autorenew.",
+			},
+			keyLocked: schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Locked sets whether the domain is locked against transfers to another registrar. Only used when the domain is registered; manage it afterwards with namedotcom_domain_lock.",
+			},
+			keyPurchasePrice: schema.Float64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Float64{float64planmodifier.UseStateForUnknown()},
+				Description:   "PurchasePrice is the price, in USD, of registering the domain for the given years, without Whois privacy. It is shown in the plan, including premium prices.",
+			},
+			keyPurchaseType: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "PurchaseType is the kind of purchase Name.com offers for the domain, such as registration.",
+			},
+			keyPremium: schema.BoolAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+				Description:   "Premium is true when the registry prices the domain as premium.",
+			},
+			keyRenewalPrice: schema.Float64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Float64{float64planmodifier.UseStateForUnknown()},
+				Description:   "RenewalPrice is the annual renewal price of the domain, in USD, which may differ from the purchase price.",
+			},
+			keyOrderID: schema.Int32Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int32{int32planmodifier.UseStateForUnknown()},
+				Description:   "OrderID identifies the registration order. It is 0 for imported domains.",
+			},
+			keyCreateDate: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "CreateDate is when the domain was created at the registry.",
+			},
+			keyExpireDate: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "ExpireDate is when the domain expires.",
+			},
+		},
+	}
+}
+
+func (r *domainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects a negative price guard, which could never be met.
+func (r *domainResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var maxPrice types.Float64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyMaxPurchasePrice), &maxPrice)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !maxPrice.IsNull() && !maxPrice.IsUnknown() && maxPrice.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyMaxPurchasePrice),
+			"Invalid max_purchase_price",
+			fmt.Sprintf("max_purchase_price must not be negative, got %g.", maxPrice.ValueFloat64()),
+		)
+	}
+}
+
+// ModifyPlan prices a domain that is about to be registered, so the plan shows
+// what the purchase will cost (premium prices included) and fails when the
+// domain is not available or costs more than max_purchase_price. For a domain
+// that is already registered it warns about changes to attributes that only
+// apply at registration.
+func (r *domainResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Destroying only removes the domain from state; nothing to price.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan domainModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state domainModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		warnRegistrationOnlyChanges(state, plan, &resp.Diagnostics)
+
+		return
+	}
+
+	if plan.DomainName.IsUnknown() || plan.Years.IsUnknown() || r.client == nil {
+		return
+	}
+
+	result, err := checkAvailabilityAPI(ctx, r.client, plan.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not price domain",
+			fmt.Sprintf("Checking the availability of %s failed, so its price is not shown; it is checked again before purchasing: %s",
+				plan.DomainName.ValueString(), err),
+		)
+
+		return
+	}
+
+	price, ok := checkPurchase(result, plan.Years.ValueInt32(), plan.MaxPurchasePrice, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	plan.PurchasePrice = types.Float64Value(price)
+	plan.PurchaseType = types.StringValue(purchaseTypeOf(result))
+	plan.Premium = types.BoolValue(result.Premium)
+	plan.RenewalPrice = types.Float64Value(result.RenewalPrice)
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
+// warnRegistrationOnlyChanges warns about each attribute that only applies at
+// registration and differs from state, since changing it afterwards is only
+// recorded in state. Attributes that are null in state (after an import) are
+// not reported.
+func warnRegistrationOnlyChanges(state, plan domainModel, diags *diag.Diagnostics) {
+	changes := []struct {
+		key          string
+		prior, value attr.Value
+	}{
+		{keyYears, state.Years, plan.Years},
+		{keyTLDRequirements, state.TLDRequirements, plan.TLDRequirements},
+		{keyNameservers, state.Nameservers, plan.Nameservers},
+		{keyContacts, state.Contacts, plan.Contacts},
+		{keyPrivacyEnabled, state.PrivacyEnabled, plan.PrivacyEnabled},
+		{keyAutorenewEnabled, state.AutorenewEnabled, plan.AutorenewEnabled},
+		{keyLocked, state.Locked, plan.Locked},
+	}
+
+	for _, change := range changes {
+		if change.prior.IsNull() || change.value.IsUnknown() || change.value.Equal(change.prior) {
+			continue
+		}
+
+		diags.AddAttributeWarning(
+			path.Root(change.key),
+			"Attribute only applies at registration",
+			fmt.Sprintf("%s is only used when the domain is registered. The change is recorded in state but not applied to %s.",
+				change.key, plan.DomainName.ValueString()),
+		)
+	}
+}
+
+func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := plan.DomainName.ValueString()
+
+	// The price is checked again: it may have changed since the plan, and the
+	// plan may not have been able to check it at all.
+	result, err := checkAvailabilityAPI(ctx, r.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error checking domain availability", err.Error())
+
+		return
+	}
+
+	price, ok := checkPurchase(result, plan.Years.ValueInt32(), plan.MaxPurchasePrice, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	if !plan.PurchasePrice.IsUnknown() && plan.PurchasePrice.ValueFloat64() != price {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyPurchasePrice),
+			"Domain price changed",
+			fmt.Sprintf("Registering %s now costs %.2f USD instead of the planned %.2f USD. Run plan again to review the new price.",
+				domainName, price, plan.PurchasePrice.ValueFloat64()),
+		)
+
+		return
+	}
+
+	request, diags := plan.createRequest(ctx, result, price)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := createDomainAPI(ctx, r.client, request)
+	if err != nil {
+		resp.Diagnostics.AddError("Error registering domain", err.Error())
+
+		return
+	}
+
+	domain := created.Domain
+	if domain == nil {
+		domain = &namecom.Domain{DomainName: domainName}
+	}
+
+	plan.PurchasePrice = types.Float64Value(price)
+	plan.PurchaseType = types.StringValue(purchaseTypeOf(result))
+	plan.Premium = types.BoolValue(result.Premium)
+	plan.RenewalPrice = types.Float64Value(result.RenewalPrice)
+	plan.OrderID = types.Int32Value(created.Order)
+
+	// The domain is registered and paid for from here on, so failures to apply
+	// the remaining settings are warnings: an error would taint the resource,
+	// and replacing it would try to register the domain again.
+	r.applyRegistrationSettings(ctx, plan, domain, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(plan.fromDomain(ctx, domain)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// applyRegistrationSettings locks the domain and sets autorenew when the
+// created domain does not already match the plan, updating domain in place.
+func (r *domainResource) applyRegistrationSettings(ctx context.Context, plan domainModel, domain *namecom.Domain, diags *diag.Diagnostics) {
+	domainName := plan.DomainName.ValueString()
+
+	if !plan.Locked.IsUnknown() && plan.Locked.ValueBool() != domain.Locked {
+		err := setDomainLockAPI(ctx, r.client, domainName, plan.Locked.ValueBool())
+		if err != nil {
+			diags.AddAttributeWarning(path.Root(keyLocked), "Could not set domain lock", err.Error())
+		} else {
+			domain.Locked = plan.Locked.ValueBool()
+		}
+	}
+
+	if !plan.AutorenewEnabled.IsUnknown() && plan.AutorenewEnabled.ValueBool() != domain.AutorenewEnabled {
+		err := setAutorenewAPI(ctx, r.client, domainName, plan.AutorenewEnabled.ValueBool())
+		if err != nil {
+			diags.AddAttributeWarning(path.Root(keyAutorenewEnabled), "Could not set autorenew", err.Error())
+		} else {
+			domain.AutorenewEnabled = plan.AutorenewEnabled.ValueBool()
+		}
+	}
+}
+
+func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, found, err := readDomainAPI(ctx, r.client, state.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading domain", err.Error())
+
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.Append(state.fromDomain(ctx, domain)...)
+
+	// CreateDomain does not report the renewal price, so the one from the
+	// availability check is kept until GetDomain has one.
+	if domain.RenewalPrice != 0 || state.RenewalPrice.IsNull() {
+		state.RenewalPrice = types.Float64Value(domain.RenewalPrice)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update records the new configuration in state. Every attribute that can
+// change in place only applies at registration or is the price guard, so
+// there is nothing to send to the API; ModifyPlan has already warned about it.
+func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan domainModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the domain from state: Name.com domains cannot be
+// deleted, and stay registered until they expire.
+func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state domainModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.AddWarning(
+		"Domain left registered",
+		fmt.Sprintf("%s was removed from state only. Domains cannot be deleted; it stays registered until %s unless renewed.",
+			state.DomainName.ValueString(), state.ExpireDate.ValueString()),
+	)
+}
+
+// ImportState seeds id and domain_name from the domain name; Read fills in
+// the rest.
+func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyDomainName), req.ID)...)
+}
+
+// createRequest builds the CreateDomain request for the planned domain,
+// passing the price and purchase type from the availability check so premium
+// domains are bought at the price that was checked against the guard.
+func (m domainModel) createRequest(
+	ctx context.Context,
+	result *namecom.SearchResult,
+	price float64,
+) (*namecom.CreateDomainRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	nameservers, nsDiags := extractNameservers(ctx, m.Nameservers)
+	diags.Append(nsDiags...)
+
+	contacts, contactDiags := expandContacts(ctx, m.Contacts)
+	diags.Append(contactDiags...)
+
+	var requirements map[string]string
+
+	if !m.TLDRequirements.IsNull() && !m.TLDRequirements.IsUnknown() {
+		diags.Append(m.TLDRequirements.ElementsAs(ctx, &requirements, false)...)
+	}
+
+	return &namecom.CreateDomainRequest{
+		Domain: &namecom.Domain{
+			DomainName:       m.DomainName.ValueString(),
+			Nameservers:      nameservers,
+			Contacts:         contacts,
+			PrivacyEnabled:   m.PrivacyEnabled.ValueBool(),
+			Locked:           m.Locked.ValueBool(),
+			AutorenewEnabled: m.AutorenewEnabled.ValueBool(),
+		},
+		PurchasePrice:   price,
+		PurchaseType:    purchaseTypeOf(result),
+		Years:           m.Years.ValueInt32(),
+		TldRequirements: requirements,
+	}, diags
+}
+
+// fromDomain copies what the API reports about a registered domain into the
+// model. The attributes that only apply at registration are filled in only
+// when they are unknown (after create) or null (after import), so later
+// changes made through other resources do not show up as drift here.
+func (m *domainModel) fromDomain(ctx context.Context, domain *namecom.Domain) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = types.StringValue(domain.DomainName)
+	m.DomainName = reconcileDNSValue(m.DomainName, domain.DomainName)
+	m.ExpireDate = types.StringValue(domain.ExpireDate)
+	m.CreateDate = types.StringValue(domain.CreateDate)
+
+	if m.Nameservers.IsUnknown() || m.Nameservers.IsNull() {
+		nameservers, setDiags := types.SetValueFrom(ctx, hostnameType{}, domain.Nameservers)
+		diags.Append(setDiags...)
+
+		m.Nameservers = nameservers
+	}
+
+	fillBool := func(value *types.Bool, actual bool) {
+		if value.IsUnknown() || value.IsNull() {
+			*value = types.BoolValue(actual)
+		}
+	}
+
+	fillBool(&m.PrivacyEnabled, domain.PrivacyEnabled)
+	fillBool(&m.AutorenewEnabled, domain.AutorenewEnabled)
+	fillBool(&m.Locked, domain.Locked)
+
+	// Imported domains were not bought through this resource.
+	if m.PurchasePrice.IsNull() {
+		m.PurchasePrice = types.Float64Value(0)
+		m.PurchaseType = types.StringValue("")
+		m.Premium = types.BoolValue(false)
+		m.OrderID = types.Int32Value(0)
+	}
+
+	return diags
+}
+
+// checkPurchase reports through diags why the domain cannot be bought for the
+// given years within maxPrice, and otherwise returns the price.
+func checkPurchase(result *namecom.SearchResult, years int32, maxPrice types.Float64, diags *diag.Diagnostics) (float64, bool) {
+	if !result.Purchasable {
+		diags.AddAttributeError(
+			path.Root(keyDomainName),
+			"Domain not available",
+			fmt.Sprintf("%s cannot be registered: it is already taken or not offered by Name.com.", result.DomainName),
+		)
+
+		return 0, false
+	}
+
+	price := totalPrice(result.PurchasePrice, years)
+
+	if !maxPrice.IsUnknown() && price > maxPrice.ValueFloat64() {
+		premium := ""
+		if result.Premium {
+			premium = "premium "
+		}
+
+		diags.AddAttributeError(
+			path.Root(keyMaxPurchasePrice),
+			"Domain price exceeds max_purchase_price",
+			fmt.Sprintf("Registering the %sdomain %s for %d year(s) costs %.2f USD, more than max_purchase_price of %.2f USD.",
+				premium, result.DomainName, years, price, maxPrice.ValueFloat64()),
+		)
+
+		return 0, false
+	}
+
+	return price, true
+}
+
+// totalPrice is the price of a registration of the given years, rounded to
+// cents so the comparison with the guard is not thrown off by float error.
+func totalPrice(annual float64, years int32) float64 {
+	const cents = 100
+
+	return math.Round(annual*float64(years)*cents) / cents
+}
+
+// purchaseTypeOf returns the purchase type of an availability result,
+// defaulting to a regular registration.
+func purchaseTypeOf(result *namecom.SearchResult) string {
+	if result.PurchaseType == "" {
+		return purchaseTypeRegistration
+	}
+
+	return result.PurchaseType
+}
+
+// checkAvailabilityAPI checks whether a domain can be registered, and at what
+// price, via the Name.com API.
+func checkAvailabilityAPI(ctx context.Context, client *namecom.NameCom, domainName string) (*namecom.SearchResult, error) {
+	results, err := checkAvailabilityBatchAPI(ctx, client, []string{domainName})
+	if err != nil {
+		return nil, err
+	}
+
+	return results[0], nil
+}
+
+// availabilityBatchSize is the most domain names Name.com checks in one
+// CheckAvailability call.
+const availabilityBatchSize = 50
+
+// checkAvailabilityBatchAPI checks several domains via the Name.com API, in
+// batches of availabilityBatchSize. The results are in the order of
+// domainNames, since the API does not guarantee one.
+func checkAvailabilityBatchAPI(ctx context.Context, client *namecom.NameCom, domainNames []string) ([]*namecom.SearchResult, error) {
+	byName := make(map[string]*namecom.SearchResult, len(domainNames))
+
+	for batch := range slices.Chunk(domainNames, availabilityBatchSize) {
+		err := RespectRateLimits(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "rate limiting error")
+		}
+
+		response, err := client.CheckAvailability(&namecom.AvailabilityRequest{DomainNames: batch})
+		if err != nil {
+			return nil, errors.Wrap(err, "Error CheckAvailability")
+		}
+
+		for _, result := range response.Results {
+			byName[hostKey(result.DomainName)] = result
+		}
+	}
+
+	results := make([]*namecom.SearchResult, 0, len(domainNames))
+
+	for _, domainName := range domainNames {
+		result, ok := byName[hostKey(domainName)]
+		if !ok {
+			return nil, errors.Newf("CheckAvailability returned no result for %s", domainName)
+		}
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+// createDomainAPI registers a domain via the Name.com API.
+func createDomainAPI(
+	ctx context.Context,
+	client *namecom.NameCom,
+	request *namecom.CreateDomainRequest,
+) (*namecom.CreateDomainResponse, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "rate limiting error")
+	}
+
+	response, err := client.CreateDomain(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error CreateDomain")
+	}
+
+	return response, nil
+}
+
+// setDomainLockAPI locks or unlocks a domain via the Name.com API.
+func setDomainLockAPI(ctx context.Context, client *namecom.NameCom, domainName string, locked bool) error {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "rate limiting error")
+	}
+
+	if locked {
+		_, err = client.LockDomain(&namecom.LockDomainRequest{DomainName: domainName})
+		if err != nil {
+			return errors.Wrap(err, "Error LockDomain")
+		}
+
+		return nil
+	}
+
+	_, err = client.UnlockDomain(&namecom.UnlockDomainRequest{DomainName: domainName})
+	if err != nil {
+		return errors.Wrap(err, "Error UnlockDomain")
+	}
+
+	return nil
+}
+
+// setAutorenewAPI enables or disables autorenew for a domain via the Name.com API.
+func setAutorenewAPI(ctx context.Context, client *namecom.NameCom, domainName string, enabled bool) error {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "rate limiting error")
+	}
+
+	if enabled {
+		_, err = client.EnableAutorenew(&namecom.EnableAutorenewForDomainRequest{DomainName: domainName})
+		if err != nil {
+			return errors.Wrap(err, "Error EnableAutorenew")
+		}
+
+		return nil
+	}
+
+	_, err = client.DisableAutorenew(&namecom.DisableAutorenewForDomainRequest{DomainName: domainName})
+	if err != nil {
+		return errors.Wrap(err, "Error DisableAutorenew")
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package namedotcom
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the resource satisfies the required framework interfaces.
+var (
+	_ resource.Resource                = (*domainAutorenewResource)(nil)
+	_ resource.ResourceWithConfigure   = (*domainAutorenewResource)(nil)
+	_ resource.ResourceWithImportState = (*domainAutorenewResource)(nil)
+)
+
+// Values of on_destroy: what destroying a domain setting resource does to the
+// domain.
+const (
+	onDestroyKeep    = "keep"
+	onDestroyDisable = "disable"
+)
+
+// domainAutorenewResource enforces whether a domain renews automatically.
+type domainAutorenewResource struct {
+	client *namecom.NameCom
+}
+
+// domainAutorenewModel maps the domain autorenew schema to a Go struct.
+type domainAutorenewModel struct {
+	ID         types.String  `tfsdk:"id"`
+	DomainName hostnameValue `tfsdk:"domain_name"`
+	Enabled    types.Bool    `tfsdk:"enabled"`
+	OnDestroy  types.String  `tfsdk:"on_destroy"`
+}
+
+// NewDomainAutorenewResource is the resource factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the resource.Resource interface.
+func NewDomainAutorenewResource() resource.Resource {
+	return &domainAutorenewResource{}
+}
+
+func (r *domainAutorenewResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_autorenew"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (r *domainAutorenewResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
+				Description:   "DomainName is the punycode encoded value of the domain name. Changing this forces a new resource.",
+			},
+			keyEnabled: schema.BoolAttribute{
+				Required:    true,
+				Description: "Enabled sets whether the domain renews automatically before it expires. A change made outside Terraform shows up as drift and is reverted on the next apply.",
+			},
+			keyOnDestroy: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(onDestroyKeep),
+				Validators:  []validator.String{stringvalidator.OneOf(onDestroyKeep, onDestroyDisable)},
+				Description: "OnDestroy is what destroying the resource does: keep leaves autorenew as it is, disable turns it off. Defaults to keep.",
+			},
+		},
+	}
+}
+
+func (r *domainAutorenewResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *domainAutorenewResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainAutorenewModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setAutorenewAPI(ctx, r.client, plan.DomainName.ValueString(), plan.Enabled.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting autorenew", err.Error())
+
+		return
+	}
+
+	r.refreshState(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *domainAutorenewResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainAutorenewModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshState(ctx, &state, &resp.State, &resp.Diagnostics)
+}
+
+func (r *domainAutorenewResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state domainAutorenewModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing only on_destroy needs no API call.
+	if !plan.Enabled.Equal(state.Enabled) {
+		err := setAutorenewAPI(ctx, r.client, plan.DomainName.ValueString(), plan.Enabled.ValueBool())
+		if err != nil {
+			if isNotFoundError(err) {
+				resp.State.RemoveResource(ctx)
+
+				return
+			}
+
+			resp.Diagnostics.AddError("Error setting autorenew", err.Error())
+
+			return
+		}
+	}
+
+	r.refreshState(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *domainAutorenewResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state domainAutorenewModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.OnDestroy.ValueString() != onDestroyDisable {
+		return
+	}
+
+	err := setAutorenewAPI(ctx, r.client, state.DomainName.ValueString(), false)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Error disabling autorenew", err.Error())
+
+		return
+	}
+}
+
+// ImportState seeds id and domain_name from the domain name; Read fills in
+// enabled and on_destroy takes its default.
+func (r *domainAutorenewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyDomainName), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyOnDestroy), onDestroyKeep)...)
+}
+
+// refreshState fetches the domain from the API and writes its autorenew
+// setting into state, removing the resource on a not-found error.
+func (r *domainAutorenewResource) refreshState(
+	ctx context.Context,
+	model *domainAutorenewModel,
+	state *tfsdk.State,
+	diags *diag.Diagnostics,
+) {
+	domain, found, err := readDomainAPI(ctx, r.client, model.DomainName.ValueString())
+	if err != nil {
+		diags.AddError("Error reading domain", err.Error())
+
+		return
+	}
+
+	if !found {
+		state.RemoveResource(ctx)
+
+		return
+	}
+
+	model.ID = types.StringValue(domain.DomainName)
+	model.DomainName = reconcileDNSValue(model.DomainName, domain.DomainName)
+	model.Enabled = types.BoolValue(domain.AutorenewEnabled)
+
+	diags.Append(state.Set(ctx, model)...)
+}
@@ -0,0 +1,243 @@
+package namedotcom
+
+import (
+	"context"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the resource satisfies the required framework interfaces.
+var (
+	_ resource.Resource                = (*domainContactsResource)(nil)
+	_ resource.ResourceWithConfigure   = (*domainContactsResource)(nil)
+	_ resource.ResourceWithImportState = (*domainContactsResource)(nil)
+)
+
+// domainContactsResource manages the registrant, admin, tech and billing
+// contacts of a domain.
+type domainContactsResource struct {
+	client *namecom.NameCom
+}
+
+// domainContactsModel maps the domain contacts schema to a Go struct. Admin,
+// tech and billing are nil when they are left to default to the registrant.
+type domainContactsModel struct {
+	ID         types.String  `tfsdk:"id"`
+	DomainName hostnameValue `tfsdk:"domain_name"`
+	Registrant *contactModel `tfsdk:"registrant"`
+	Admin      *contactModel `tfsdk:"admin"`
+	Tech       *contactModel `tfsdk:"tech"`
+	Billing    *contactModel `tfsdk:"billing"`
+}
+
+// NewDomainContactsResource is the resource factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the resource.Resource interface.
+func NewDomainContactsResource() resource.Resource {
+	return &domainContactsResource{}
+}
+
+func (r *domainContactsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_contacts"
+}
+
+// Schema declares one nested attribute per role. Nested attributes rather
+// than blocks let a single contact object, such as a local value, be assigned
+// to several roles.
+//
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (r *domainContactsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	role := func(description string) schema.SingleNestedAttribute {
+		return schema.SingleNestedAttribute{
+			Optional:    true,
+			Attributes:  contactAttributes(),
+			Description: description + " Defaults to the registrant.",
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
+				Description:   "DomainName is the punycode encoded value of the domain name. Changing this forces a new resource.",
+			},
+			keyRegistrant: schema.SingleNestedAttribute{
+				Required:    true,
+				Attributes:  contactAttributes(),
+				Description: "Registrant is the legal owner of the domain.",
+			},
+			keyAdmin:   role("Admin is the administrative contact of the domain."),
+			keyTech:    role("Tech is the technical contact of the domain."),
+			keyBilling: role("Billing is the billing contact of the domain."),
+		},
+	}
+}
+
+func (r *domainContactsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+func (r *domainContactsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainContactsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setContactsAPI(ctx, r.client, plan.DomainName.ValueString(), plan.apiContacts())
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting contacts", err.Error())
+
+		return
+	}
+
+	plan.ID = types.StringValue(plan.DomainName.ValueString())
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *domainContactsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainContactsModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain, found, err := readDomainAPI(ctx, r.client, state.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading domain", err.Error())
+
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	state.ID = types.StringValue(domain.DomainName)
+	state.DomainName = reconcileDNSValue(state.DomainName, domain.DomainName)
+	state.fromAPI(domain.Contacts)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *domainContactsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan domainContactsModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setContactsAPI(ctx, r.client, plan.DomainName.ValueString(), plan.apiContacts())
+	if err != nil {
+		if isNotFoundError(err) {
+			resp.State.RemoveResource(ctx)
+
+			return
+		}
+
+		resp.Diagnostics.AddError("Error setting contacts", err.Error())
+
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete only removes the contacts from state: a domain always has contacts,
+// so there is nothing to reset them to.
+func (r *domainContactsResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState seeds id and domain_name from the domain name; Read fills in
+// the contacts, leaving out roles that match the registrant.
+func (r *domainContactsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyDomainName), req.ID)...)
+}
+
+// apiContacts converts the model into the API form, filling the roles left
+// out with the registrant.
+func (m *domainContactsModel) apiContacts() *namecom.Contacts {
+	registrant := expandContact(m.Registrant)
+
+	role := func(contact *contactModel) *namecom.Contact {
+		if contact == nil {
+			return registrant
+		}
+
+		return expandContact(contact)
+	}
+
+	return &namecom.Contacts{
+		Registrant: registrant,
+		Admin:      role(m.Admin),
+		Tech:       role(m.Tech),
+		Billing:    role(m.Billing),
+	}
+}
+
+// fromAPI copies the contacts read from the API into the model, ignoring
+// cosmetic differences. A role left out stays left out while it still matches
+// the registrant.
+func (m *domainContactsModel) fromAPI(contacts *namecom.Contacts) {
+	if contacts == nil {
+		contacts = &namecom.Contacts{}
+	}
+
+	m.Registrant = flattenContact(m.Registrant, contacts.Registrant)
+
+	role := func(prior *contactModel, contact *namecom.Contact) *contactModel {
+		if prior == nil && sameContact(contact, contacts.Registrant) {
+			return nil
+		}
+
+		return flattenContact(prior, contact)
+	}
+
+	m.Admin = role(m.Admin, contacts.Admin)
+	m.Tech = role(m.Tech, contacts.Tech)
+	m.Billing = role(m.Billing, contacts.Billing)
+}
+
+// setContactsAPI sets the contacts of a domain via the Name.com API.
+func setContactsAPI(ctx context.Context, client *namecom.NameCom, domainName string, contacts *namecom.Contacts) error {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "rate limiting error")
+	}
+
+	_, err = client.SetContacts(&namecom.SetContactsRequest{
+		DomainName: domainName,
+		Contacts:   contacts,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error SetContacts")
+	}
+
+	return nil
+}
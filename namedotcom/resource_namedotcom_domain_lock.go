@@ -0,0 +1,251 @@
+package namedotcom
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the resource satisfies the required framework interfaces.
+var (
+	_ resource.Resource                   = (*domainLockResource)(nil)
+	_ resource.ResourceWithConfigure      = (*domainLockResource)(nil)
+	_ resource.ResourceWithImportState    = (*domainLockResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*domainLockResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*domainLockResource)(nil)
+)
+
+// domainLockResource enforces the registrar transfer lock of a domain.
+type domainLockResource struct {
+	client *namecom.NameCom
+}
+
+// domainLockModel maps the domain lock schema to a Go struct.
+type domainLockModel struct {
+	ID            types.String  `tfsdk:"id"`
+	DomainName    hostnameValue `tfsdk:"domain_name"`
+	Locked        types.Bool    `tfsdk:"locked"`
+	PreventUnlock types.Bool    `tfsdk:"prevent_unlock"`
+}
+
+// NewDomainLockResource is the resource factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the resource.Resource interface.
+func NewDomainLockResource() resource.Resource {
+	return &domainLockResource{}
+}
+
+func (r *domainLockResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_lock"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (r *domainLockResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
+				Description:   "DomainName is the punycode encoded value of the domain name. Changing this forces a new resource.",
+			},
+			keyLocked: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Locked sets whether the domain is locked against transfers to another registrar. Defaults to true. An unlock made outside Terraform shows up as drift and is reverted on the next apply.",
+			},
+			keyPreventUnlock: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "PreventUnlock makes any configuration that sets locked to false fail validation. Set it to false, deliberately, to unlock the domain. Defaults to true.",
+			},
+		},
+	}
+}
+
+func (r *domainLockResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig turns a plan to unlock the domain into an error unless
+// prevent_unlock is explicitly set to false. prevent_unlock defaults to true,
+// so leaving it out of the configuration keeps the safeguard on. Values not
+// known yet are checked again by ModifyPlan.
+func (r *domainLockResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config domainLockModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if unlockPrevented(config) {
+		addUnlockPreventedError(&resp.Diagnostics)
+	}
+}
+
+// ModifyPlan applies the prevent_unlock safeguard to the planned values, which
+// are known by the time the plan is final even when locked or prevent_unlock
+// came from another resource and was unknown during validation.
+func (r *domainLockResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan domainLockModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if unlockPrevented(plan) {
+		addUnlockPreventedError(&resp.Diagnostics)
+	}
+}
+
+// unlockPrevented reports whether the model sets locked to false while
+// prevent_unlock is on (or left to its default). Unknown values are not
+// judged.
+func unlockPrevented(model domainLockModel) bool {
+	if model.Locked.IsNull() || model.Locked.IsUnknown() || model.Locked.ValueBool() {
+		return false
+	}
+
+	return !model.PreventUnlock.IsUnknown() && (model.PreventUnlock.IsNull() || model.PreventUnlock.ValueBool())
+}
+
+// addUnlockPreventedError reports an unlock refused by prevent_unlock.
+func addUnlockPreventedError(diags *diag.Diagnostics) {
+	diags.AddAttributeError(
+		path.Root(keyLocked),
+		"Unlocking is prevented",
+		"locked = false would allow the domain to be transferred to another registrar, and prevent_unlock is on. "+
+			"Set prevent_unlock = false to unlock the domain deliberately.",
+	)
+}
+
+func (r *domainLockResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainLockModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setDomainLockAPI(ctx, r.client, plan.DomainName.ValueString(), plan.Locked.ValueBool())
+	if err != nil {
+		resp.Diagnostics.AddError("Error setting domain lock", err.Error())
+
+		return
+	}
+
+	r.refreshState(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *domainLockResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainLockModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshState(ctx, &state, &resp.State, &resp.Diagnostics)
+}
+
+func (r *domainLockResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state domainLockModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing only prevent_unlock needs no API call.
+	if !plan.Locked.Equal(state.Locked) {
+		err := setDomainLockAPI(ctx, r.client, plan.DomainName.ValueString(), plan.Locked.ValueBool())
+		if err != nil {
+			if isNotFoundError(err) {
+				resp.State.RemoveResource(ctx)
+
+				return
+			}
+
+			resp.Diagnostics.AddError("Error setting domain lock", err.Error())
+
+			return
+		}
+	}
+
+	r.refreshState(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+// Delete leaves the domain locked or unlocked as it is: unlocking on destroy
+// would open the domain to transfers just because it left Terraform.
+func (r *domainLockResource) Delete(_ context.Context, _ resource.DeleteRequest, _ *resource.DeleteResponse) {
+}
+
+// ImportState seeds id and domain_name from the domain name; Read fills in
+// locked and prevent_unlock takes its default.
+func (r *domainLockResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyDomainName), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyPreventUnlock), true)...)
+}
+
+// refreshState fetches the domain from the API and writes its lock state into
+// state, removing the resource on a not-found error.
+func (r *domainLockResource) refreshState(
+	ctx context.Context,
+	model *domainLockModel,
+	state *tfsdk.State,
+	diags *diag.Diagnostics,
+) {
+	domain, found, err := readDomainAPI(ctx, r.client, model.DomainName.ValueString())
+	if err != nil {
+		diags.AddError("Error reading domain", err.Error())
+
+		return
+	}
+
+	if !found {
+		state.RemoveResource(ctx)
+
+		return
+	}
+
+	model.ID = types.StringValue(domain.DomainName)
+	model.DomainName = reconcileDNSValue(model.DomainName, domain.DomainName)
+	model.Locked = types.BoolValue(domain.Locked)
+
+	diags.Append(state.Set(ctx, model)...)
+}
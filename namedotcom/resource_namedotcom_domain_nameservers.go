@@ -2,14 +2,21 @@ package namedotcom
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/namedotcom/go/v4/namecom"
@@ -17,9 +24,11 @@ import (
 
 // Ensure the resource satisfies the required framework interfaces.
 var (
-	_ resource.Resource                 = (*domainNameServersResource)(nil)
-	_ resource.ResourceWithConfigure    = (*domainNameServersResource)(nil)
-	_ resource.ResourceWithUpgradeState = (*domainNameServersResource)(nil)
+	_ resource.Resource                   = (*domainNameServersResource)(nil)
+	_ resource.ResourceWithConfigure      = (*domainNameServersResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*domainNameServersResource)(nil)
+	_ resource.ResourceWithUpgradeState   = (*domainNameServersResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*domainNameServersResource)(nil)
 )
 
 // nameserversSchemaVersion is the current schema version. It was bumped from 0
@@ -27,6 +36,25 @@ var (
 // SDKv2 provider (which also used version 1) is read without an upgrade.
 const nameserversSchemaVersion = 1
 
+// Values of on_destroy for nameservers. Resetting restores the account default
+// nameservers, which takes a domain offline when its zone lives elsewhere.
+// They differ from onDestroyKeep and onDestroyDisable because a reset replaces
+// the nameservers rather than switching a setting off.
+const (
+	onDestroyReset  = "reset"
+	onDestroyRetain = "retain"
+)
+
+// Limits on a nameserver set and its hostnames. A delegation holds at most 13
+// nameservers, RFC 1034 asks for at least two for redundancy, and a
+// nameserver must be a fully qualified name. The length limits are those of
+// checkTargetName.
+const (
+	minNameservers    = 2
+	maxNameservers    = 13
+	minHostnameLabels = 2
+)
+
 // domainNameServersResource manages the nameservers configured for a domain.
 type domainNameServersResource struct {
 	client *namecom.NameCom
@@ -34,9 +62,13 @@ type domainNameServersResource struct {
 
 // nameserversModel maps the nameservers schema to a Go struct.
 type nameserversModel struct {
-	ID          types.String `tfsdk:"id"`
-	DomainName  types.String `tfsdk:"domain_name"`
-	Nameservers types.Set    `tfsdk:"nameservers"`
+	ID                   types.String  `tfsdk:"id"`
+	DomainName           hostnameValue `tfsdk:"domain_name"`
+	Nameservers          types.Set     `tfsdk:"nameservers"`
+	UseAccountDefaults   types.Bool    `tfsdk:"use_account_defaults"`
+	EffectiveNameservers types.Set     `tfsdk:"effective_nameservers"`
+	OnDestroy            types.String  `tfsdk:"on_destroy"`
+	AllowSingle          types.Bool    `tfsdk:"allow_single_nameserver"`
 }
 
 // NewDomainNameServersResource is the resource factory registered with the provider.
@@ -61,15 +93,43 @@ func (r *domainNameServersResource) Schema(_ context.Context, _ resource.SchemaR
 				Description:   descIDIsDomainName,
 			},
 			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
 				Required:      true,
-				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange()},
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
 				Description:   "DomainName is the punycode encoded value of the domain name. Changing this forces a new resource.",
 			},
 			keyNameservers: schema.SetAttribute{
 				Optional:    true,
+				ElementType: hostnameType{},
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, maxNameservers),
+					setvalidator.ValueStringsAre(nameserverValidator{}),
+				},
+				Description: "Nameservers is the set of nameservers for this domain. Order is not significant; the registry treats nameservers as a set. Each must be a fully qualified hostname, and at most 13 may be set. Fewer than two is an error unless allow_single_nameserver is true. Leave it unset, with use_account_defaults = true, to use your account default nameservers.",
+			},
+			keyUseAccountDefaults: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "UseAccountDefaults points the domain at your account default nameservers. It conflicts with nameservers. Changes to the account defaults, and nameservers set outside Terraform, are reported in effective_nameservers with a warning and are not drift. Defaults to false.",
+			},
+			keyEffectiveNS: schema.SetAttribute{
 				Computed:    true,
-				ElementType: types.StringType,
-				Description: "Nameservers is the set of nameservers for this domain. Order is not significant; the registry treats nameservers as a set. If unspecified it defaults to your account default nameservers.",
+				ElementType: hostnameType{},
+				Description: "EffectiveNameservers is the set of nameservers the domain uses, as reported by Name.com. It equals nameservers when they are set, and otherwise shows the account defaults.",
+			},
+			keyOnDestroy: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(onDestroyRetain),
+				Validators:  []validator.String{stringvalidator.OneOf(onDestroyReset, onDestroyRetain)},
+				Description: "OnDestroy is what destroying the resource does: retain leaves the nameservers as they are, reset restores your account default nameservers. Defaults to retain; versions before on_destroy existed always reset.",
+			},
+			keyAllowSingleNS: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "AllowSingleNameserver permits a nameservers set with only one entry, which leaves the domain unresolvable while that server is down. Defaults to false.",
 			},
 		},
 	}
@@ -84,6 +144,137 @@ func (r *domainNameServersResource) Configure(_ context.Context, req resource.Co
 	r.client = client
 }
 
+// ValidateConfig rejects nameservers that are the same host written twice, a
+// single nameserver unless allow_single_nameserver is set, and nameservers
+// combined with use_account_defaults. Leaving both out still selects the
+// account defaults, with a warning asking to say so.
+func (r *domainNameServersResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var config nameserversModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Nameservers.IsNull() {
+		if config.UseAccountDefaults.IsNull() || (!config.UseAccountDefaults.IsUnknown() && !config.UseAccountDefaults.ValueBool()) {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(keyNameservers),
+				"Nameservers not set",
+				"Without nameservers the domain uses your account default nameservers. "+
+					"Set use_account_defaults = true to make that explicit, or list the nameservers.",
+			)
+		}
+
+		return
+	}
+
+	if config.UseAccountDefaults.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyUseAccountDefaults),
+			"Conflicting nameserver settings",
+			"use_account_defaults = true cannot be combined with nameservers. Remove one of them.",
+		)
+
+		return
+	}
+
+	if config.Nameservers.IsUnknown() {
+		return
+	}
+
+	elements := config.Nameservers.Elements()
+	seen := make(map[string]string, len(elements))
+
+	for _, element := range elements {
+		nameserver, ok := element.(hostnameValue)
+		if !ok || nameserver.IsNull() || nameserver.IsUnknown() {
+			continue
+		}
+
+		key := hostKey(nameserver.ValueString())
+		if first, exists := seen[key]; exists {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(keyNameservers),
+				"Duplicate nameserver",
+				fmt.Sprintf("%q and %q are the same nameserver; list each one once.", first, nameserver.ValueString()),
+			)
+
+			continue
+		}
+
+		seen[key] = nameserver.ValueString()
+	}
+
+	if len(elements) >= minNameservers || config.AllowSingle.IsUnknown() || config.AllowSingle.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		path.Root(keyNameservers),
+		"Too few nameservers",
+		"A domain with a single nameserver stops resolving whenever that server is down; registries expect at least two. "+
+			"Set allow_single_nameserver = true to use one deliberately.",
+	)
+}
+
+// ModifyPlan plans effective_nameservers: the configured nameservers when they
+// are set, and otherwise the account defaults already in state, so a plan that
+// keeps using the defaults does not show them as changing. State written
+// before nameservers stopped being computed holds the API's list in
+// nameservers; that list moves to effective_nameservers without any change at
+// Name.com.
+func (r *domainNameServersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan nameserversModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch {
+	case plan.Nameservers.IsUnknown():
+		return
+	case !plan.Nameservers.IsNull():
+		plan.EffectiveNameservers = plan.Nameservers
+	case req.State.Raw.IsNull():
+		return
+	default:
+		var state nameserversModel
+
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		switch {
+		case state.Nameservers.IsNull():
+			plan.EffectiveNameservers = state.EffectiveNameservers
+		case legacyNameservers(state, plan):
+			plan.EffectiveNameservers = state.Nameservers
+
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root(keyNameservers),
+				"Nameservers moved to effective_nameservers",
+				"State written by an earlier version of the provider holds the nameservers read from Name.com in nameservers. "+
+					"They move to effective_nameservers, and the nameservers at Name.com are left unchanged.",
+			)
+		default:
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, &plan)...)
+}
+
 func (r *domainNameServersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan nameserversModel
 
@@ -121,13 +312,23 @@ func (r *domainNameServersResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *domainNameServersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	var plan nameserversModel
+	var plan, state nameserversModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	// Changing only on_destroy, the safeguards or how the nameservers are
+	// represented in state needs no API call.
+	if plan.Nameservers.Equal(state.Nameservers) || legacyNameservers(state, plan) {
+		r.refreshState(ctx, &plan, &resp.State, &resp.Diagnostics)
+
+		return
+	}
+
 	nameservers, diags := extractNameservers(ctx, plan.Nameservers)
 	resp.Diagnostics.Append(diags...)
 
@@ -159,6 +360,10 @@ func (r *domainNameServersResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
+	if state.OnDestroy.ValueString() != onDestroyReset {
+		return
+	}
+
 	// Resetting the nameservers to an empty list restores the account defaults.
 	err := setNameserversAPI(ctx, r.client, state.DomainName.ValueString(), nil)
 	if err != nil && !isNotFoundError(err) {
@@ -206,7 +411,14 @@ func upgradeNameserversV0ToV1(ctx context.Context, req resource.UpgradeStateRequ
 		return
 	}
 
-	nameservers, diags := types.SetValue(types.StringType, prior.Nameservers.Elements())
+	var values []string
+
+	resp.Diagnostics.Append(prior.Nameservers.ElementsAs(ctx, &values, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameservers, diags := types.SetValueFrom(ctx, hostnameType{}, values)
 	resp.Diagnostics.Append(diags...)
 
 	if resp.Diagnostics.HasError() {
@@ -214,21 +426,39 @@ func upgradeNameserversV0ToV1(ctx context.Context, req resource.UpgradeStateRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &nameserversModel{
-		ID:          prior.ID,
-		DomainName:  prior.DomainName,
-		Nameservers: nameservers,
+		ID:                   prior.ID,
+		DomainName:           hostnameValue{StringValue: prior.DomainName},
+		Nameservers:          nameservers,
+		UseAccountDefaults:   types.BoolValue(false),
+		EffectiveNameservers: nameservers,
+		OnDestroy:            types.StringValue(onDestroyRetain),
+		AllowSingle:          types.BoolValue(false),
 	})...)
 }
 
+// legacyNameservers reports whether state comes from before nameservers
+// stopped being computed, and the configuration still leaves both nameservers
+// and use_account_defaults out. State then holds the nameservers read from the
+// API, which may have been changed outside Terraform, so dropping them from
+// nameservers must not reset the domain. use_account_defaults is null only in
+// such state: every plan since sets it through its default.
+func legacyNameservers(state, plan nameserversModel) bool {
+	return plan.Nameservers.IsNull() && !state.Nameservers.IsNull() &&
+		state.UseAccountDefaults.IsNull() && !plan.UseAccountDefaults.ValueBool()
+}
+
 // refreshState fetches the domain from the API and writes the current
-// nameservers into state, removing the resource on a not-found error.
+// nameservers into state, removing the resource on a not-found error. The
+// nameservers attribute is only refreshed when it is set: when the domain uses
+// the account defaults it stays null, and only effective_nameservers follows
+// the API.
 func (r *domainNameServersResource) refreshState(
 	ctx context.Context,
 	model *nameserversModel,
 	state *tfsdk.State,
 	diags *diag.Diagnostics,
 ) {
-	domain, found, err := readNameserversAPI(ctx, r.client, model.DomainName.ValueString())
+	domain, found, err := readDomainAPI(ctx, r.client, model.DomainName.ValueString())
 	if err != nil {
 		diags.AddError("Error reading domain", err.Error())
 
@@ -241,20 +471,82 @@ func (r *domainNameServersResource) refreshState(
 		return
 	}
 
-	nameservers, convertDiags := types.SetValueFrom(ctx, types.StringType, domain.Nameservers)
+	nameservers, convertDiags := types.SetValueFrom(ctx, hostnameType{}, domain.Nameservers)
 	diags.Append(convertDiags...)
 
 	if diags.HasError() {
 		return
 	}
 
+	// Name.com does not report the account defaults, so with nameservers
+	// unset a change to them cannot be told apart from nameservers set
+	// outside Terraform. Neither is drift; a change is surfaced as a warning.
+	previous := model.EffectiveNameservers
+	if model.Nameservers.IsNull() && !previous.IsNull() && !previous.IsUnknown() && !previous.Equal(nameservers) {
+		diags.AddWarning(
+			"Nameservers changed",
+			fmt.Sprintf("The nameservers of %s changed from %s to %s. With use_account_defaults = true this is not drift, "+
+				"whether the account defaults changed or the nameservers were set outside Terraform. "+
+				"Set nameservers to manage them instead.",
+				domain.DomainName, previous, nameservers),
+		)
+	}
+
 	model.ID = types.StringValue(domain.DomainName)
-	model.DomainName = types.StringValue(domain.DomainName)
-	model.Nameservers = nameservers
+	model.DomainName = reconcileDNSValue(model.DomainName, domain.DomainName)
+	model.EffectiveNameservers = nameservers
+
+	if !model.Nameservers.IsNull() {
+		model.Nameservers = nameservers
+	}
 
 	diags.Append(state.Set(ctx, model)...)
 }
 
+// nameserverValidator requires a nameserver to be a fully qualified hostname:
+// at least two labels of letters, digits and hyphens, with an optional
+// trailing dot.
+type nameserverValidator struct{}
+
+func (v nameserverValidator) Description(_ context.Context) string {
+	return "value must be a fully qualified hostname"
+}
+
+func (v nameserverValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v nameserverValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	err := checkNameserver(req.ConfigValue.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, "Invalid nameserver", err.Error())
+	}
+}
+
+// checkNameserver reports why a name cannot be a nameserver: it must be a
+// host name as checkTargetName accepts it, fully qualified, and without the
+// underscores that only service labels use.
+func checkNameserver(name string) error {
+	err := checkTargetName(name)
+	if err != nil {
+		return errors.Wrapf(err, "%q", name)
+	}
+
+	if strings.Contains(name, "_") {
+		return errors.Newf("%q may contain only letters, digits and hyphens; give internationalized names in punycode", name)
+	}
+
+	if len(strings.Split(strings.TrimSuffix(name, "."), ".")) < minHostnameLabels {
+		return errors.Newf("%q is not fully qualified; give the full hostname, such as ns1.example.com", name)
+	}
+
+	return nil
+}
+
 // extractNameservers reads a nameservers set into a slice, treating a null or
 // unknown value as an empty list (which resets the domain to account defaults).
 func extractNameservers(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
@@ -287,9 +579,9 @@ func setNameserversAPI(ctx context.Context, client *namecom.NameCom, domainName
 	return nil
 }
 
-// readNameserversAPI fetches a domain via the Name.com API. The boolean result
+// readDomainAPI fetches a domain via the Name.com API. The boolean result
 // is false when the domain no longer exists.
-func readNameserversAPI(ctx context.Context, client *namecom.NameCom, domainName string) (*namecom.Domain, bool, error) {
+func readDomainAPI(ctx context.Context, client *namecom.NameCom, domainName string) (*namecom.Domain, bool, error) {
 	err := RespectRateLimits(ctx)
 	if err != nil {
 		return nil, false, errors.Wrap(err, "rate limiting error")
@@ -0,0 +1,527 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the resource satisfies the required framework interfaces.
+var (
+	_ resource.Resource                   = (*domainTransferResource)(nil)
+	_ resource.ResourceWithConfigure      = (*domainTransferResource)(nil)
+	_ resource.ResourceWithImportState    = (*domainTransferResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*domainTransferResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*domainTransferResource)(nil)
+)
+
+// Waiting for a transfer to complete, in seconds.
+const (
+	defaultTransferWaitTimeout  = 3600
+	defaultTransferPollInterval = 60
+	minTransferPollInterval     = 10
+)
+
+// Transfer statuses that end a transfer. Name.com reports statuses as free
+// text, so they are matched case-insensitively.
+const (
+	transferStatusCompleted = "completed"
+	transferStatusCanceled  = "canceled"
+	transferStatusCancelled = "cancelled"
+	transferStatusFailed    = "failed"
+	transferStatusRejected  = "rejected"
+)
+
+// domainTransferResource requests the transfer of a domain to Name.com from
+// another registrar and tracks it until it completes.
+type domainTransferResource struct {
+	client *namecom.NameCom
+}
+
+// domainTransferModel maps the domain transfer schema to a Go struct. AuthCode
+// is write-only, so it is only ever set in the configuration.
+type domainTransferModel struct {
+	ID                types.String  `tfsdk:"id"`
+	DomainName        hostnameValue `tfsdk:"domain_name"`
+	AuthCode          types.String  `tfsdk:"auth_code"`
+	PrivacyEnabled    types.Bool    `tfsdk:"privacy_enabled"`
+	PurchasePrice     types.Float64 `tfsdk:"purchase_price"`
+	WaitForCompletion types.Bool    `tfsdk:"wait_for_completion"`
+	WaitTimeout       types.Int32   `tfsdk:"wait_timeout"`
+	PollInterval      types.Int32   `tfsdk:"poll_interval"`
+	Status            types.String  `tfsdk:"status"`
+	Email             types.String  `tfsdk:"email"`
+	OrderID           types.Int32   `tfsdk:"order_id"`
+	TotalPaid         types.Float64 `tfsdk:"total_paid"`
+}
+
+// NewDomainTransferResource is the resource factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the resource.Resource interface.
+func NewDomainTransferResource() resource.Resource {
+	return &domainTransferResource{}
+}
+
+func (r *domainTransferResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain_transfer"
+}
+
+//nolint:lll,funlen // Attribute descriptions are intentionally verbose for the registry docs; the schema is a single declaration.
+func (r *domainTransferResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Transfers a domain to Name.com from another registrar. Destroying the resource cancels the transfer while it is still pending.",
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
+				Description:   "DomainName is the punycode encoded value of the domain name to transfer. Changing this forces a new resource.",
+			},
+			keyAuthCode: schema.StringAttribute{
+				Optional:    true,
+				Sensitive:   true,
+				WriteOnly:   true,
+				Description: "AuthCode is the authorization code from the current registrar. Most TLDs require one. It is write-only: it is sent when the transfer is requested and never stored in state. Requires Terraform 1.11 or later.",
+			},
+			keyPrivacyEnabled: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "PrivacyEnabled buys Whois privacy together with the transfer, at an additional cost. Defaults to false. Only used when the transfer is requested.",
+			},
+			keyPurchasePrice: schema.Float64Attribute{
+				Optional:    true,
+				Description: "PurchasePrice is the price, in USD, of the transfer. Required for premium domains. Only used when the transfer is requested.",
+			},
+			keyWaitForCompletion: schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "WaitForCompletion makes apply poll the transfer until it completes, fails or wait_timeout passes. Defaults to false, which only requests the transfer; status is then refreshed on every plan. Turning it on for a pending transfer makes the next apply wait.",
+			},
+			keyWaitTimeout: schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(defaultTransferWaitTimeout),
+				Validators:  []validator.Int32{int32validator.AtLeast(1)},
+				Description: "WaitTimeout is how long, in seconds, to wait for the transfer with wait_for_completion. Defaults to 3600. A transfer still pending afterwards, or one that cannot be read while waiting, is only reported as a warning.",
+			},
+			keyPollInterval: schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(defaultTransferPollInterval),
+				Validators:  []validator.Int32{int32validator.AtLeast(minTransferPollInterval)},
+				Description: "PollInterval is how often, in seconds, to check the transfer with wait_for_completion. Defaults to 60, and must be at least 10.",
+			},
+			keyStatus: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Status is the current status of the transfer, such as Completed, refreshed from Name.com.",
+			},
+			keyEmail: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   "Email is the address the transfer approval email was sent to, for TLDs that need one.",
+			},
+			keyOrderID: schema.Int32Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Int32{int32planmodifier.UseStateForUnknown()},
+				Description:   "OrderID identifies the transfer order. It is 0 for imported transfers.",
+			},
+			keyTotalPaid: schema.Float64Attribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.Float64{float64planmodifier.UseStateForUnknown()},
+				Description:   "TotalPaid is the total amount paid for the transfer, in USD, including Whois privacy and VAT.",
+			},
+		},
+	}
+}
+
+func (r *domainTransferResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects a negative purchase price.
+func (r *domainTransferResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var price types.Float64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyPurchasePrice), &price)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !price.IsNull() && !price.IsUnknown() && price.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyPurchasePrice),
+			"Invalid purchase_price",
+			fmt.Sprintf("purchase_price must not be negative, got %g.", price.ValueFloat64()),
+		)
+	}
+}
+
+func (r *domainTransferResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan domainTransferModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The auth code is write-only, so it is only present in the configuration.
+	var authCode types.String
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyAuthCode), &authCode)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, err := createTransferAPI(ctx, r.client, &namecom.CreateTransferRequest{
+		DomainName:     plan.DomainName.ValueString(),
+		AuthCode:       authCode.ValueString(),
+		PrivacyEnabled: plan.PrivacyEnabled.ValueBool(),
+		PurchasePrice:  plan.PurchasePrice.ValueFloat64(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error requesting domain transfer", err.Error())
+
+		return
+	}
+
+	transfer := created.Transfer
+	if transfer == nil {
+		transfer = &namecom.Transfer{DomainName: plan.DomainName.ValueString()}
+	}
+
+	plan.OrderID = types.Int32Value(created.Order)
+	plan.TotalPaid = types.Float64Value(created.TotalPaid)
+	plan.fromTransfer(transfer)
+
+	if plan.WaitForCompletion.ValueBool() {
+		r.wait(ctx, &plan, &resp.Diagnostics)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *domainTransferResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state domainTransferModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	transfer, found, err := readTransferAPI(ctx, r.client, state.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading domain transfer", err.Error())
+
+		return
+	}
+
+	if !found {
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	state.fromTransfer(transfer)
+
+	// An imported transfer has no order details to read back.
+	if state.OrderID.IsNull() {
+		state.OrderID = types.Int32Value(0)
+	}
+
+	if state.TotalPaid.IsNull() {
+		state.TotalPaid = types.Float64Value(0)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// ModifyPlan marks status and email unknown when the update will wait for the
+// transfer (see waitsOnUpdate), since waiting rewrites them from the API.
+func (r *domainTransferResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to wait for on create or destroy.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state domainTransferModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || !waitsOnUpdate(state, plan) {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(keyStatus), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(keyEmail), types.StringUnknown())...)
+}
+
+// Update records the new configuration in state and, when waiting is turned
+// on for a transfer that is still pending, waits for it. The remaining
+// attributes only apply when the transfer is requested.
+func (r *domainTransferResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state domainTransferModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if waitsOnUpdate(state, plan) {
+		// Start from the last known status, so a wait that cannot read the
+		// transfer still leaves known values in state.
+		plan.Status = state.Status
+		plan.Email = state.Email
+
+		r.wait(ctx, &plan, &resp.Diagnostics)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// waitsOnUpdate reports whether an update waits for the transfer: only when
+// wait_for_completion is turned on while the transfer is still pending. A
+// transfer that already waited once, and timed out, is not waited for again
+// on unrelated changes; its status is refreshed on every plan instead.
+func waitsOnUpdate(state, plan domainTransferModel) bool {
+	return plan.WaitForCompletion.ValueBool() && !state.WaitForCompletion.ValueBool() &&
+		!transferFinished(state.Status.ValueString())
+}
+
+// Delete cancels the transfer while it is still pending. A finished transfer
+// is only removed from state: a completed one leaves the domain at Name.com.
+func (r *domainTransferResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state domainTransferModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domainName := state.DomainName.ValueString()
+
+	transfer, found, err := readTransferAPI(ctx, r.client, domainName)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading domain transfer", err.Error())
+
+		return
+	}
+
+	if !found || transferFinished(transfer.Status) {
+		return
+	}
+
+	err = cancelTransferAPI(ctx, r.client, domainName)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Error canceling domain transfer", err.Error())
+
+		return
+	}
+}
+
+// ImportState seeds id and domain_name from the domain name; Read fills in
+// the status and the remaining attributes take their defaults.
+func (r *domainTransferResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyDomainName), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyPrivacyEnabled), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyWaitForCompletion), false)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyWaitTimeout), defaultTransferWaitTimeout)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyPollInterval), defaultTransferPollInterval)...)
+}
+
+// wait polls the transfer until it finishes, updating the model as it goes.
+// Only a transfer that finished without completing is an error. Running out of
+// time, or failing to read the transfer, is a warning: the transfer has been
+// paid for and keeps going, and an error would taint the resource so the next
+// apply cancels it.
+func (r *domainTransferResource) wait(ctx context.Context, model *domainTransferModel, diags *diag.Diagnostics) {
+	domainName := model.DomainName.ValueString()
+
+	transfer, err := waitForTransfer(
+		ctx,
+		r.client,
+		domainName,
+		time.Duration(model.PollInterval.ValueInt32())*time.Second,
+		time.Duration(model.WaitTimeout.ValueInt32())*time.Second,
+	)
+	if transfer != nil {
+		model.fromTransfer(transfer)
+	}
+
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		diags.AddWarning(
+			"Domain transfer still pending",
+			fmt.Sprintf("The transfer of %s had not completed after %d seconds; its status is %q. "+
+				"It keeps going, and status is refreshed on the next plan.",
+				domainName, model.WaitTimeout.ValueInt32(), model.Status.ValueString()),
+		)
+	case err != nil:
+		diags.AddWarning(
+			"Could not wait for domain transfer",
+			fmt.Sprintf("The transfer of %s was requested, but checking on it failed: %s. "+
+				"It keeps going, and status is refreshed on the next plan.",
+				domainName, err),
+		)
+	case !transferCompleted(model.Status.ValueString()):
+		diags.AddError(
+			"Domain transfer did not complete",
+			fmt.Sprintf("The transfer of %s ended with status %q.", domainName, model.Status.ValueString()),
+		)
+	}
+}
+
+// fromTransfer copies what the API reports about a transfer into the model.
+func (m *domainTransferModel) fromTransfer(transfer *namecom.Transfer) {
+	m.ID = types.StringValue(transfer.DomainName)
+	m.DomainName = reconcileDNSValue(m.DomainName, transfer.DomainName)
+	m.Status = types.StringValue(transfer.Status)
+	m.Email = types.StringValue(transfer.Email)
+}
+
+// transferCompleted reports whether a transfer status means the domain is now
+// at Name.com.
+func transferCompleted(status string) bool {
+	return strings.EqualFold(strings.TrimSpace(status), transferStatusCompleted)
+}
+
+// transferFinished reports whether a transfer status is final, whether the
+// transfer completed or not.
+func transferFinished(status string) bool {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case transferStatusCompleted, transferStatusCanceled, transferStatusCancelled, transferStatusFailed, transferStatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+// waitForTransfer polls a transfer every interval until its status is final,
+// returning the last transfer read. It returns context.DeadlineExceeded when
+// the transfer would still be pending after timeout. The deadline only bounds
+// the polling, not the API calls, so the rate limiter never refuses a read
+// because the deadline is near.
+func waitForTransfer(
+	ctx context.Context,
+	client *namecom.NameCom,
+	domainName string,
+	interval, timeout time.Duration,
+) (*namecom.Transfer, error) {
+	deadline := time.Now().Add(timeout)
+
+	for {
+		transfer, found, err := readTransferAPI(ctx, client, domainName)
+		if err != nil {
+			return nil, err
+		}
+
+		if !found {
+			return nil, errors.Newf("transfer of %s not found", domainName)
+		}
+
+		if transferFinished(transfer.Status) {
+			return transfer, nil
+		}
+
+		if time.Now().Add(interval).After(deadline) {
+			return transfer, errors.Wrap(context.DeadlineExceeded, "Error waiting for transfer")
+		}
+
+		select {
+		case <-ctx.Done():
+			return transfer, errors.Wrap(ctx.Err(), "Error waiting for transfer")
+		case <-time.After(interval):
+		}
+	}
+}
+
+// createTransferAPI requests a domain transfer via the Name.com API.
+func createTransferAPI(
+	ctx context.Context,
+	client *namecom.NameCom,
+	request *namecom.CreateTransferRequest,
+) (*namecom.CreateTransferResponse, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "rate limiting error")
+	}
+
+	resp, err := client.CreateTransfer(request)
+	if err != nil {
+		return nil, errors.Wrap(err, "Error CreateTransfer")
+	}
+
+	return resp, nil
+}
+
+// readTransferAPI fetches a domain transfer via the Name.com API. A not-found
+// error is reported as found == false rather than an error.
+func readTransferAPI(ctx context.Context, client *namecom.NameCom, domainName string) (*namecom.Transfer, bool, error) {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return nil, false, errors.Wrap(err, "rate limiting error")
+	}
+
+	transfer, err := client.GetTransfer(&namecom.GetTransferRequest{DomainName: domainName})
+	if err != nil {
+		if isNotFoundError(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, errors.Wrap(err, "Error GetTransfer")
+	}
+
+	return transfer, true, nil
+}
+
+// cancelTransferAPI cancels a pending domain transfer via the Name.com API.
+func cancelTransferAPI(ctx context.Context, client *namecom.NameCom, domainName string) error {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "rate limiting error")
+	}
+
+	_, err = client.CancelTransfer(&namecom.CancelTransferRequest{DomainName: domainName})
+	if err != nil {
+		return errors.Wrap(err, "Error CancelTransfer")
+	}
+
+	return nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/cockroachdb/errors"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -31,28 +32,41 @@ const (
 	recordTypeSRV = "SRV"
 )
 
+// The remaining record types Name.com can store.
+const (
+	recordTypeA     = "A"
+	recordTypeAAAA  = "AAAA"
+	recordTypeANAME = "ANAME"
+	recordTypeCNAME = "CNAME"
+	recordTypeNS    = "NS"
+	recordTypeTXT   = "TXT"
+)
+
 // Ensure the resource satisfies the required framework interfaces.
 var (
 	_ resource.Resource                   = (*recordResource)(nil)
 	_ resource.ResourceWithConfigure      = (*recordResource)(nil)
 	_ resource.ResourceWithImportState    = (*recordResource)(nil)
+	_ resource.ResourceWithModifyPlan     = (*recordResource)(nil)
 	_ resource.ResourceWithValidateConfig = (*recordResource)(nil)
 )
 
 // recordResource manages a single Name.com DNS record.
 type recordResource struct {
-	client *namecom.NameCom
+	client  *namecom.NameCom
+	records *recordCache
 }
 
 // recordModel maps the record schema to a Go struct.
 type recordModel struct {
-	ID         types.String `tfsdk:"id"`
-	RecordID   types.Int32  `tfsdk:"record_id"`
-	DomainName types.String `tfsdk:"domain_name"`
-	Host       types.String `tfsdk:"host"`
-	RecordType types.String `tfsdk:"record_type"`
-	Answer     types.String `tfsdk:"answer"`
-	Priority   types.Int32  `tfsdk:"priority"`
+	ID            types.String    `tfsdk:"id"`
+	RecordID      types.Int32     `tfsdk:"record_id"`
+	DomainName    hostnameValue   `tfsdk:"domain_name"`
+	Host          hostnameValue   `tfsdk:"host"`
+	RecordType    recordTypeValue `tfsdk:"record_type"`
+	Answer        answerValue     `tfsdk:"answer"`
+	Priority      types.Int32     `tfsdk:"priority"`
+	AdoptExisting types.Bool      `tfsdk:"adopt_existing"`
 }
 
 // NewRecordResource is the resource factory registered with the provider.
@@ -80,23 +94,27 @@ func (r *recordResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Description:   "Unique record id assigned by Name.com (numeric form of `id`).",
 			},
 			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
 				Optional:      true,
-				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange()},
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
 				Description:   "DomainName is the zone that the record belongs to. Changing this forces a new resource.",
 			},
 			keyHost: schema.StringAttribute{
+				CustomType:  hostnameType{},
 				Optional:    true,
 				Description: "Host is the hostname relative to the zone.",
 			},
 			keyRecordType: schema.StringAttribute{
+				CustomType:    recordTypeType{},
 				Optional:      true,
-				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange()},
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[recordTypeSemantics]()},
 				Description:   "Type is one of the following: A, AAAA, ANAME, CNAME, MX, NS, SRV, or TXT. Changing this forces a new resource.",
 			},
 			keyAnswer: schema.StringAttribute{
-				Optional: true,
+				CustomType: answerType{},
+				Optional:   true,
 				//nolint:lll // One sentence covering every supported record type.
-				Description: "Answer is the record value: the IP address for A and AAAA records, the target for ANAME, CNAME, MX, NS, and SRV records, or the text for TXT records.",
+				Description: "Answer is the record value: the IP address for A and AAAA records, the target for ANAME, CNAME, MX, and NS records, `weight port target` for SRV records, or the text for TXT records. It is checked against the record type at plan time.",
 			},
 			keyPriority: schema.Int32Attribute{
 				Optional:   true,
@@ -104,23 +122,30 @@ func (r *recordResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				//nolint:lll // One sentence describing where priority applies.
 				Description: "Priority is used by MX and SRV records, where a lower value is preferred; it is ignored for all other record types. Valid range is 0-65535.",
 			},
+			keyAdoptExisting: schema.BoolAttribute{
+				Optional: true,
+				//nolint:lll // One sentence describing the opt-in behaviour.
+				Description: "AdoptExisting makes create take over an existing record with the same host, record_type and answer (compared like a refresh does: addresses by value, DNS names ignoring letter case and a trailing dot, TXT text exactly) instead of creating a duplicate, so a record made by hand or by an interrupted apply can be brought under management. A different priority on the adopted record is updated to the configured one. Defaults to false.",
+			},
 		},
 	}
 }
 
 func (r *recordResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	data, ok := configureProviderData(req.ProviderData, &resp.Diagnostics)
 	if !ok {
 		return
 	}
 
-	r.client = client
+	r.client = data.client
+	r.records = data.records
 }
 
-// ValidateConfig rejects a priority set on a record type that ignores it: only
-// MX and SRV records use priority. Name.com silently drops priority for other
-// types, so without this check such a config would produce a perpetual plan
-// diff (configured value vs the zero the API stores).
+// ValidateConfig rejects configurations Name.com would only refuse at apply
+// time, often after other records in the same run have already changed: a
+// priority on a record type that ignores it, and an answer that does not fit
+// the record type. Values that are not yet known (computed from another
+// resource) are skipped and left to apply-time behaviour.
 func (r *recordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config recordModel
 
@@ -129,13 +154,20 @@ func (r *recordResource) ValidateConfig(ctx context.Context, req resource.Valida
 		return
 	}
 
-	// Nothing to validate when priority is unset, or when the type is not yet
-	// known (computed from another resource): defer to apply-time behaviour.
-	if config.Priority.IsNull() || config.Priority.IsUnknown() {
+	if config.RecordType.IsNull() || config.RecordType.IsUnknown() {
 		return
 	}
 
-	if config.RecordType.IsNull() || config.RecordType.IsUnknown() {
+	validateRecordPriority(config, &resp.Diagnostics)
+	validateRecordAnswer(config, &resp.Diagnostics)
+}
+
+// validateRecordPriority rejects a priority set on a record type that ignores
+// it: only MX and SRV records use priority. Name.com silently drops priority
+// for other types, so without this check such a config would produce a
+// perpetual plan diff (configured value vs the zero the API stores).
+func validateRecordPriority(config recordModel, diags *diag.Diagnostics) {
+	if config.Priority.IsNull() || config.Priority.IsUnknown() {
 		return
 	}
 
@@ -143,7 +175,7 @@ func (r *recordResource) ValidateConfig(ctx context.Context, req resource.Valida
 	case recordTypeMX, recordTypeSRV:
 		return
 	default:
-		resp.Diagnostics.AddAttributeError(
+		diags.AddAttributeError(
 			path.Root(keyPriority),
 			"Priority not supported for this record type",
 			fmt.Sprintf("priority applies only to MX and SRV records, but record_type is %q.", config.RecordType.ValueString()),
@@ -151,6 +183,148 @@ func (r *recordResource) ValidateConfig(ctx context.Context, req resource.Valida
 	}
 }
 
+// validateRecordAnswer checks that the answer has the shape its record type
+// requires; see checkRecordAnswer for the rules.
+func validateRecordAnswer(config recordModel, diags *diag.Diagnostics) {
+	if config.Answer.IsNull() || config.Answer.IsUnknown() {
+		return
+	}
+
+	recordType := strings.ToUpper(config.RecordType.ValueString())
+
+	err := checkRecordAnswer(recordType, config.Answer.ValueString())
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root(keyAnswer),
+			"Invalid answer for record type",
+			fmt.Sprintf("answer %q is not valid for a %s record: %s.", config.Answer.ValueString(), recordType, err),
+		)
+	}
+}
+
+// ModifyPlan checks a planned record against the CNAME rules before anything
+// is applied: a CNAME may not sit at the zone apex, which is an error, and no
+// other record may share a host with a CNAME. Name.com refuses such records,
+// or applies some of them and then fails, so the check consults the domain's
+// existing records (listed once per domain and cached). A conflicting record
+// may belong to a resource this same plan destroys, which ModifyPlan cannot
+// see, so a conflict is only a warning. Records whose domain, host and type
+// are unchanged are not re-checked, the record that adopt_existing would take
+// over is not a conflict, and a failure to list the records only produces a
+// warning.
+func (r *recordResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to check when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan recordModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.DomainName.IsUnknown() || plan.Host.IsUnknown() || plan.RecordType.IsUnknown() || plan.RecordType.IsNull() {
+		return
+	}
+
+	recordType := strings.ToUpper(plan.RecordType.ValueString())
+
+	var (
+		state  recordModel
+		selfID int32
+	)
+
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if sameRecordSlot(state, plan) {
+			return
+		}
+
+		// The id is parsed rather than read from record_id, which is 0 in state
+		// upgraded from the SDKv2 provider until the first refresh.
+		selfID, _ = parseRecordID(state.ID.ValueString())
+	}
+
+	if recordType == recordTypeCNAME && hostKey(plan.Host.ValueString()) == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyRecordType),
+			"CNAME not allowed at the zone apex",
+			fmt.Sprintf("A CNAME cannot be created at the apex of %s, which always carries other records. "+
+				"Use an ANAME record instead; Name.com resolves it to the target's addresses.", plan.DomainName.ValueString()),
+		)
+
+		return
+	}
+
+	if r.client == nil || r.records == nil {
+		return
+	}
+
+	records, err := r.records.list(ctx, r.client, plan.DomainName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Could not check for CNAME conflicts",
+			fmt.Sprintf("Listing the records of %s failed, so CNAME conflicts will only be reported by the API: %s",
+				plan.DomainName.ValueString(), err),
+		)
+
+		return
+	}
+
+	// A record that adopt_existing will take over is the resource itself, not
+	// a conflict.
+	if req.State.Raw.IsNull() && plan.AdoptExisting.ValueBool() {
+		if adopted := findMatchingRecord(records, plan); adopted != nil {
+			selfID = adopted.ID
+		}
+	}
+
+	conflict := cnameConflict(records, selfID, plan.Host.ValueString(), recordType)
+	if conflict == nil {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root(keyHost),
+		"CNAME conflicts with another record",
+		fmt.Sprintf("A CNAME must be the only record at its host, but %s already has a %s record (id %d) at host %q. "+
+			"Unless this plan destroys that record first, the apply will fail: remove the other record in an earlier "+
+			"apply, or use an ANAME record if the CNAME is meant to alias this host.",
+			plan.DomainName.ValueString(), conflict.Type, conflict.ID, plan.Host.ValueString()),
+	)
+}
+
+// sameRecordSlot reports whether the domain, host and type of a planned record
+// are unchanged from state, in which case the CNAME rules were already checked.
+func sameRecordSlot(state, plan recordModel) bool {
+	return state.DomainName.Equal(plan.DomainName) && state.Host.Equal(plan.Host) && state.RecordType.Equal(plan.RecordType)
+}
+
+// cnameConflict returns an existing record that cannot coexist with a planned
+// record of recordType at host: any other record when planning a CNAME, or a
+// CNAME when planning any other type. The record with selfID (the resource
+// being updated) is ignored.
+func cnameConflict(records []*namecom.Record, selfID int32, host, recordType string) *namecom.Record {
+	for _, record := range records {
+		if record.ID == selfID || hostKey(record.Host) != hostKey(host) {
+			continue
+		}
+
+		existingIsCNAME := strings.EqualFold(record.Type, recordTypeCNAME)
+		if recordType == recordTypeCNAME || existingIsCNAME {
+			return record
+		}
+	}
+
+	return nil
+}
+
 func (r *recordResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan recordModel
 
@@ -159,7 +333,9 @@ func (r *recordResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
-	record, err := createRecordAPI(ctx, r.client, apiRecordFromModel(plan))
+	record, err := r.createOrAdopt(ctx, plan)
+	r.invalidate(plan.DomainName.ValueString())
+
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating record", err.Error())
 
@@ -186,7 +362,7 @@ func (r *recordResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	record, err := readRecordAPI(ctx, r.client, state.DomainName.ValueString(), recordID)
+	record, err := r.readRecord(ctx, state.DomainName.ValueString(), recordID)
 	if err != nil {
 		// The record was deleted outside Terraform: drop it from state so the
 		// next plan recreates it instead of failing.
@@ -229,6 +405,8 @@ func (r *recordResource) Update(ctx context.Context, req resource.UpdateRequest,
 	}
 
 	record, err := updateRecordAPI(ctx, r.client, recordID, apiRecordFromModel(plan))
+	r.invalidate(plan.DomainName.ValueString())
+
 	if err != nil {
 		// The record was deleted outside Terraform between plan and apply: drop
 		// it from state so the next plan recreates it, matching the Read path.
@@ -264,6 +442,8 @@ func (r *recordResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	}
 
 	err = deleteRecordAPI(ctx, r.client, state.DomainName.ValueString(), recordID)
+	r.invalidate(state.DomainName.ValueString())
+
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting record", err.Error())
 
@@ -325,8 +505,10 @@ func recordCreateState(plan recordModel, record *namecom.Record) recordModel {
 
 // recordReadState refreshes the state from the API record. For the
 // user-controlled attributes it keeps the representation already in state when
-// that is semantically equal to the API value, so Name.com's canonicalization
-// (upper-cased type, trailing-dot answer) does not surface as perpetual drift.
+// that is semantically equal to the API value (see dns_types.go; the answer is
+// compared by the record's type, so a TXT case change is drift), so Name.com's
+// canonicalization (upper-cased type, trailing-dot answer, compressed IPv6
+// address) does not surface as perpetual drift.
 // A genuinely different value — or an empty one during import — is adopted from
 // the API so real drift is still detected.
 func recordReadState(state recordModel, record *namecom.Record) recordModel {
@@ -336,9 +518,9 @@ func recordReadState(state recordModel, record *namecom.Record) recordModel {
 	// queried by it, so the returned domain always matches. It is deliberately
 	// not adopted from the API response, so a punycode/case difference can never
 	// trigger a spurious replacement on refresh.
-	state.Host = reconcileHostValue(state.Host, record.Host)
+	state.Host = reconcileDNSValue(state.Host, record.Host)
 	state.RecordType = reconcileDNSValue(state.RecordType, record.Type)
-	state.Answer = reconcileDNSValue(state.Answer, record.Answer)
+	state.Answer = reconcileAnswer(state.Answer, record.Type, record.Answer)
 	state.Priority = reconcilePriority(state.Priority, record.Priority)
 
 	return state
@@ -362,35 +544,6 @@ func reconcilePriority(prior types.Int32, apiValue uint32) types.Int32 {
 	return types.Int32Value(priorityToInt32(apiValue))
 }
 
-// reconcileDNSValue keeps the prior state value when it is semantically equal
-// to the API value (DNS names are case-insensitive and a trailing dot is not
-// significant); otherwise it adopts the API value.
-func reconcileDNSValue(prior types.String, apiValue string) types.String {
-	if !prior.IsNull() && dnsEqual(prior.ValueString(), apiValue) {
-		return prior
-	}
-
-	return types.StringValue(apiValue)
-}
-
-// reconcileHostValue is reconcileDNSValue for the host attribute, additionally
-// treating the apex forms "@" and "" as equivalent.
-func reconcileHostValue(prior types.String, apiValue string) types.String {
-	if !prior.IsNull() && hostEqual(prior.ValueString(), apiValue) {
-		return prior
-	}
-
-	return types.StringValue(apiValue)
-}
-
-func dnsEqual(a, b string) bool {
-	return strings.EqualFold(strings.TrimSuffix(a, "."), strings.TrimSuffix(b, "."))
-}
-
-func hostEqual(a, b string) bool {
-	return strings.EqualFold(normalizeHost(a), normalizeHost(b))
-}
-
 // normalizeHost treats the apex host "@" as the empty host.
 func normalizeHost(host string) string {
 	if host == "@" {
@@ -402,25 +555,95 @@ func normalizeHost(host string) string {
 
 // dnsReplaceDescription documents the semantic RequiresReplace behaviour.
 const dnsReplaceDescription = "Changing this to a different value forces a new resource; " +
-	"differences that are only DNS canonicalization (letter case or a trailing dot) do not."
+	"differences that are only cosmetic (such as letter case or a trailing dot) do not."
 
 // requiresReplaceOnDNSChange forces replacement only when the attribute changes
-// semantically. A difference that is purely DNS canonicalization (letter case
-// or a trailing dot) does not trigger replacement, so upgrading from state
-// written by the SDKv2 provider — which stored the API's canonical form — does
-// not destroy and recreate resources over a cosmetic mismatch.
+// under the semantic equality S. A cosmetic difference does not trigger
+// replacement, so upgrading from state written by the SDKv2 provider — which
+// stored the API's canonical form — does not destroy and recreate resources.
+// Semantic equality alone does not cover this: the framework applies it to
+// values written by the provider, not to the plan.
 //
 //nolint:ireturn // The framework schema requires a planmodifier.String value.
-func requiresReplaceOnDNSChange() planmodifier.String {
+func requiresReplaceOnDNSChange[S dnsSemantics]() planmodifier.String {
 	return stringplanmodifier.RequiresReplaceIf(
 		func(_ context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
-			resp.RequiresReplace = !dnsEqual(req.StateValue.ValueString(), req.PlanValue.ValueString())
+			var semantics S
+
+			resp.RequiresReplace = !semantics.equal(req.StateValue.ValueString(), req.PlanValue.ValueString())
 		},
 		dnsReplaceDescription,
 		dnsReplaceDescription,
 	)
 }
 
+// createOrAdopt creates the planned record, or with adopt_existing takes over
+// an existing record that matches it.
+func (r *recordResource) createOrAdopt(ctx context.Context, plan recordModel) (*namecom.Record, error) {
+	input := apiRecordFromModel(plan)
+
+	if !plan.AdoptExisting.ValueBool() {
+		return createRecordAPI(ctx, r.client, input)
+	}
+
+	// The records are listed afresh rather than from the plan-time cache: the
+	// point is to catch records that appeared since, such as those left behind
+	// by an apply that was interrupted.
+	records, err := listRecordsAPI(ctx, r.client, input.DomainName)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := findMatchingRecord(records, plan)
+	if existing == nil {
+		return createRecordAPI(ctx, r.client, input)
+	}
+
+	if existing.Priority == input.Priority {
+		return existing, nil
+	}
+
+	return updateRecordAPI(ctx, r.client, existing.ID, input)
+}
+
+// findMatchingRecord returns the first record with the planned host, type and
+// answer under the same semantic equality the refresh uses, or nil.
+func findMatchingRecord(records []*namecom.Record, plan recordModel) *namecom.Record {
+	for _, record := range records {
+		// An omitted host is the apex, so the values are compared directly
+		// rather than through semanticEqual, which treats null as unequal.
+		if (hostnameSemantics{}).equal(plan.Host.ValueString(), record.Host) &&
+			(recordTypeSemantics{}).equal(plan.RecordType.ValueString(), record.Type) &&
+			answerEqual(plan.RecordType.ValueString(), plan.Answer.ValueString(), record.Answer) {
+			return record
+		}
+	}
+
+	return nil
+}
+
+// readRecord fetches a record, serving it from the domain's cached listing
+// when possible so refreshing many records costs one ListRecords walk per
+// domain rather than a GetRecord each. A record missing from the listing is
+// fetched with GetRecord, which also reports a genuine deletion.
+func (r *recordResource) readRecord(ctx context.Context, domainName string, recordID int32) (*namecom.Record, error) {
+	if r.records != nil {
+		if record, ok := r.records.find(ctx, r.client, domainName, recordID); ok {
+			return record, nil
+		}
+	}
+
+	return readRecordAPI(ctx, r.client, domainName, recordID)
+}
+
+// invalidate drops the cached records of a domain after a write, whether or
+// not it succeeded: a failed call may still have changed the zone.
+func (r *recordResource) invalidate(domainName string) {
+	if r.records != nil {
+		r.records.invalidate(domainName)
+	}
+}
+
 // apiRecordFromModel builds the Name.com API record from the user-controlled
 // attributes of the plan. The server-assigned id is set separately by the
 // callers that need it (Update).
@@ -520,3 +743,18 @@ func deleteRecordAPI(ctx context.Context, client *namecom.NameCom, domainName st
 
 	return nil
 }
+
+// listRecordsAPI fetches every record in a zone via the Name.com API.
+func listRecordsAPI(ctx context.Context, client *namecom.NameCom, domainName string) ([]*namecom.Record, error) {
+	return listAllPages(ctx, "ListRecords",
+		func(page int32) ([]*namecom.Record, int32, error) {
+			resp, err := client.ListRecords(&namecom.ListRecordsRequest{DomainName: domainName, Page: page})
+			if err != nil {
+				return nil, 0, err
+			}
+
+			return resp.Records, resp.NextPage, nil
+		},
+		func(record *namecom.Record) int32 { return record.ID },
+	)
+}
@@ -0,0 +1,351 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// Ensure the resource satisfies the required framework interfaces.
+var (
+	_ resource.Resource                   = (*whoisPrivacyResource)(nil)
+	_ resource.ResourceWithConfigure      = (*whoisPrivacyResource)(nil)
+	_ resource.ResourceWithImportState    = (*whoisPrivacyResource)(nil)
+	_ resource.ResourceWithValidateConfig = (*whoisPrivacyResource)(nil)
+)
+
+// whoisPrivacyResource enforces Whois privacy for a domain, buying it when the
+// domain does not have it yet.
+type whoisPrivacyResource struct {
+	client *namecom.NameCom
+}
+
+// whoisPrivacyModel maps the Whois privacy schema to a Go struct.
+type whoisPrivacyModel struct {
+	ID         types.String  `tfsdk:"id"`
+	DomainName hostnameValue `tfsdk:"domain_name"`
+	Enabled    types.Bool    `tfsdk:"enabled"`
+	MaxPrice   types.Float64 `tfsdk:"max_price"`
+	Years      types.Int32   `tfsdk:"years"`
+	OnDestroy  types.String  `tfsdk:"on_destroy"`
+}
+
+// NewWhoisPrivacyResource is the resource factory registered with the provider.
+//
+//nolint:ireturn // The framework contract requires returning the resource.Resource interface.
+func NewWhoisPrivacyResource() resource.Resource {
+	return &whoisPrivacyResource{}
+}
+
+func (r *whoisPrivacyResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_whois_privacy"
+}
+
+//nolint:lll // Attribute descriptions are intentionally verbose for the registry docs.
+func (r *whoisPrivacyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			keyID: schema.StringAttribute{
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Description:   descIDIsDomainName,
+			},
+			keyDomainName: schema.StringAttribute{
+				CustomType:    hostnameType{},
+				Required:      true,
+				PlanModifiers: []planmodifier.String{requiresReplaceOnDNSChange[hostnameSemantics]()},
+				Description:   "DomainName is the punycode encoded value of the domain name. Changing this forces a new resource.",
+			},
+			keyEnabled: schema.BoolAttribute{
+				Required:    true,
+				Description: "Enabled sets whether Whois privacy hides the domain's contacts. A change made outside Terraform shows up as drift and is reverted on the next apply.",
+			},
+			keyMaxPrice: schema.Float64Attribute{
+				Optional:    true,
+				Description: "MaxPrice is the price, in USD, you expect to pay for Whois privacy when the domain does not have it yet. The Name.com API does not quote Whois privacy prices, so the provider cannot compare it with the real price; it is sent to Name.com as the expected price of the purchase. Without it, enabling privacy on a domain that does not have it fails instead of buying it.",
+			},
+			keyYears: schema.Int32Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int32default.StaticInt32(registrationYearsMin),
+				Validators:  []validator.Int32{int32validator.Between(registrationYearsMin, registrationYearsMax)},
+				Description: "Years is how long to buy Whois privacy for, 1-10 years and not past the domain's expiry. Defaults to 1. Only used when privacy is bought.",
+			},
+			keyOnDestroy: schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(onDestroyKeep),
+				Validators:  []validator.String{stringvalidator.OneOf(onDestroyKeep, onDestroyDisable)},
+				Description: "OnDestroy is what destroying the resource does: keep leaves Whois privacy as it is, disable turns it off. Defaults to keep.",
+			},
+		},
+	}
+}
+
+func (r *whoisPrivacyResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	client, ok := configureClient(req.ProviderData, &resp.Diagnostics)
+	if !ok {
+		return
+	}
+
+	r.client = client
+}
+
+// ValidateConfig rejects a negative price guard, which could never be met.
+func (r *whoisPrivacyResource) ValidateConfig(
+	ctx context.Context,
+	req resource.ValidateConfigRequest,
+	resp *resource.ValidateConfigResponse,
+) {
+	var maxPrice types.Float64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(keyMaxPrice), &maxPrice)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !maxPrice.IsNull() && !maxPrice.IsUnknown() && maxPrice.ValueFloat64() < 0 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(keyMaxPrice),
+			"Invalid max_price",
+			fmt.Sprintf("max_price must not be negative, got %g.", maxPrice.ValueFloat64()),
+		)
+	}
+}
+
+func (r *whoisPrivacyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan whoisPrivacyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.apply(ctx, plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshState(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *whoisPrivacyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state whoisPrivacyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshState(ctx, &state, &resp.State, &resp.Diagnostics)
+}
+
+func (r *whoisPrivacyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state whoisPrivacyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Changing only the purchase settings or on_destroy needs no API call.
+	if !plan.Enabled.Equal(state.Enabled) {
+		r.apply(ctx, plan, &resp.Diagnostics)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.refreshState(ctx, &plan, &resp.State, &resp.Diagnostics)
+}
+
+func (r *whoisPrivacyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state whoisPrivacyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.OnDestroy.ValueString() != onDestroyDisable {
+		return
+	}
+
+	err := setWhoisPrivacyAPI(ctx, r.client, state.DomainName.ValueString(), false)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Error disabling Whois privacy", err.Error())
+
+		return
+	}
+}
+
+// ImportState seeds id and domain_name from the domain name; Read fills in
+// enabled and the remaining attributes take their defaults.
+func (r *whoisPrivacyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyID), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyDomainName), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyYears), registrationYearsMin)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(keyOnDestroy), onDestroyKeep)...)
+}
+
+// apply enables or disables Whois privacy. Name.com only enables privacy the
+// domain already has, so when the API answers that privacy was never bought,
+// and the domain confirms it is off, privacy is bought instead, which also
+// turns it on. Any other error is reported as it is, so a rate limit, timeout
+// or outage never leads to a purchase.
+func (r *whoisPrivacyResource) apply(ctx context.Context, plan whoisPrivacyModel, diags *diag.Diagnostics) {
+	domainName := plan.DomainName.ValueString()
+
+	err := setWhoisPrivacyAPI(ctx, r.client, domainName, plan.Enabled.ValueBool())
+	if err == nil {
+		return
+	}
+
+	if !plan.Enabled.ValueBool() || !isPrivacyNotPurchasedError(err) {
+		diags.AddError("Error setting Whois privacy", err.Error())
+
+		return
+	}
+
+	domain, found, readErr := readDomainAPI(ctx, r.client, domainName)
+	if readErr != nil {
+		diags.AddError("Error reading domain", readErr.Error())
+
+		return
+	}
+
+	if !found {
+		diags.AddError("Error setting Whois privacy", err.Error())
+
+		return
+	}
+
+	if domain.PrivacyEnabled {
+		return
+	}
+
+	if plan.MaxPrice.IsNull() || plan.MaxPrice.IsUnknown() {
+		diags.AddAttributeError(
+			path.Root(keyMaxPrice),
+			"Error enabling Whois privacy",
+			fmt.Sprintf("%s does not have Whois privacy yet: %s. Set max_price to buy it.", domainName, err),
+		)
+
+		return
+	}
+
+	// Unlike a domain registration or renewal, nothing can be priced first:
+	// GetPricingForDomain only quotes purchase, renewal and transfer prices,
+	// and the API has no Whois privacy price. max_price is sent as the
+	// expected price, the only price the purchase request takes.
+	err = purchasePrivacyAPI(ctx, r.client, domainName, plan.MaxPrice.ValueFloat64(), plan.Years.ValueInt32())
+	if err != nil {
+		diags.AddError("Error buying Whois privacy", err.Error())
+	}
+}
+
+// isPrivacyNotPurchasedError reports whether enabling Whois privacy failed
+// because the API answered that the domain has not bought it. Errors that are
+// not an answer from the API, such as timeouts and rate limiting, never match.
+func isPrivacyNotPurchasedError(err error) bool {
+	var apiErr *namecom.ErrorResponse
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+
+	text := strings.ToLower(apiErr.Message + " " + apiErr.Details)
+
+	return strings.Contains(text, "privacy") && strings.Contains(text, "purchase")
+}
+
+// refreshState fetches the domain from the API and writes its Whois privacy
+// setting into state, removing the resource on a not-found error.
+func (r *whoisPrivacyResource) refreshState(
+	ctx context.Context,
+	model *whoisPrivacyModel,
+	state *tfsdk.State,
+	diags *diag.Diagnostics,
+) {
+	domain, found, err := readDomainAPI(ctx, r.client, model.DomainName.ValueString())
+	if err != nil {
+		diags.AddError("Error reading domain", err.Error())
+
+		return
+	}
+
+	if !found {
+		state.RemoveResource(ctx)
+
+		return
+	}
+
+	model.ID = types.StringValue(domain.DomainName)
+	model.DomainName = reconcileDNSValue(model.DomainName, domain.DomainName)
+	model.Enabled = types.BoolValue(domain.PrivacyEnabled)
+
+	diags.Append(state.Set(ctx, model)...)
+}
+
+// setWhoisPrivacyAPI enables or disables Whois privacy for a domain via the
+// Name.com API.
+func setWhoisPrivacyAPI(ctx context.Context, client *namecom.NameCom, domainName string, enabled bool) error {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "rate limiting error")
+	}
+
+	if enabled {
+		_, err = client.EnableWhoisPrivacy(&namecom.EnableWhoisPrivacyForDomainRequest{DomainName: domainName})
+		if err != nil {
+			return errors.Wrap(err, "Error EnableWhoisPrivacy")
+		}
+
+		return nil
+	}
+
+	_, err = client.DisableWhoisPrivacy(&namecom.DisableWhoisPrivacyForDomainRequest{DomainName: domainName})
+	if err != nil {
+		return errors.Wrap(err, "Error DisableWhoisPrivacy")
+	}
+
+	return nil
+}
+
+// purchasePrivacyAPI buys Whois privacy for a domain via the Name.com API.
+func purchasePrivacyAPI(ctx context.Context, client *namecom.NameCom, domainName string, price float64, years int32) error {
+	err := RespectRateLimits(ctx)
+	if err != nil {
+		return errors.Wrap(err, "rate limiting error")
+	}
+
+	_, err = client.PurchasePrivacy(&namecom.PrivacyRequest{
+		DomainName:    domainName,
+		PurchasePrice: price,
+		Years:         years,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Error PurchasePrivacy")
+	}
+
+	return nil
+}
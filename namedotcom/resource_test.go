@@ -151,6 +151,28 @@ func TestDNSSECResource_Schema(t *testing.T) {
 	}
 }
 
+func TestDNSSECKeysResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	res := namedotcom.NewDNSSECKeysResource()
+	attrs := resourceSchema(t, res).Attributes
+
+	assertStringForcesReplace(t, attrs, "domain_name")
+
+	// Keys change in place, so a rollover can add before it removes.
+	if !attrs["keys"].IsRequired() || forcesReplace(attrs["keys"]) {
+		t.Error("keys should be required and updated in place")
+	}
+
+	if !attrs["remove_unmanaged"].IsOptional() {
+		t.Error("remove_unmanaged should be optional")
+	}
+
+	if _, ok := res.(resource.ResourceWithImportState); !ok {
+		t.Error("dnssec_keys resource should support import")
+	}
+}
+
 func TestDomainNameServersResource_Schema(t *testing.T) {
 	t.Parallel()
 
@@ -170,8 +192,22 @@ func TestDomainNameServersResource_Schema(t *testing.T) {
 
 	assertStringForcesReplace(t, attrs, "domain_name")
 
-	if !attrs["nameservers"].IsOptional() || !attrs["nameservers"].IsComputed() {
-		t.Error("nameservers should be optional and computed")
+	// nameservers is not computed: when it is left out, what the API reports
+	// goes to effective_nameservers, so a change to the account defaults is
+	// not drift.
+	if !attrs["nameservers"].IsOptional() || attrs["nameservers"].IsComputed() {
+		t.Error("nameservers should be optional and not computed")
+	}
+
+	if !attrs["effective_nameservers"].IsComputed() || attrs["effective_nameservers"].IsOptional() {
+		t.Error("effective_nameservers should be computed only")
+	}
+
+	// Each has a default, so leaving it out of the configuration is valid.
+	for _, field := range []string{"on_destroy", "allow_single_nameserver", "use_account_defaults"} {
+		if !attrs[field].IsOptional() || !attrs[field].IsComputed() {
+			t.Errorf("%s should be optional with a default", field)
+		}
 	}
 }
 
@@ -196,6 +232,107 @@ func TestDomainNameServersResource_StateUpgrade(t *testing.T) {
 	}
 }
 
+func TestDomainResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	attrs := resourceSchema(t, namedotcom.NewDomainResource()).Attributes
+
+	assertStringForcesReplace(t, attrs, "domain_name")
+
+	// The price guard is mandatory: a domain is never bought without one.
+	if !attrs["max_purchase_price"].IsRequired() {
+		t.Error("max_purchase_price should be required")
+	}
+
+	for _, field := range []string{"purchase_price", "purchase_type", "premium", "renewal_price", "expire_date"} {
+		if !attrs[field].IsComputed() || attrs[field].IsOptional() {
+			t.Errorf("%s should be computed only", field)
+		}
+	}
+}
+
+func TestDomainAutorenewResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	attrs := resourceSchema(t, namedotcom.NewDomainAutorenewResource()).Attributes
+
+	assertStringForcesReplace(t, attrs, "domain_name")
+
+	if !attrs["enabled"].IsRequired() {
+		t.Error("enabled should be required")
+	}
+
+	if !attrs["on_destroy"].IsOptional() || !attrs["on_destroy"].IsComputed() {
+		t.Error("on_destroy should be optional with a default")
+	}
+}
+
+func TestDomainLockResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	attrs := resourceSchema(t, namedotcom.NewDomainLockResource()).Attributes
+
+	assertStringForcesReplace(t, attrs, "domain_name")
+
+	for _, field := range []string{"locked", "prevent_unlock"} {
+		if !attrs[field].IsOptional() || !attrs[field].IsComputed() {
+			t.Errorf("%s should be optional with a default", field)
+		}
+	}
+}
+
+func TestWhoisPrivacyResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	attrs := resourceSchema(t, namedotcom.NewWhoisPrivacyResource()).Attributes
+
+	assertStringForcesReplace(t, attrs, "domain_name")
+
+	if !attrs["enabled"].IsRequired() {
+		t.Error("enabled should be required")
+	}
+
+	// The price guard is only needed when privacy has to be bought.
+	if !attrs["max_price"].IsOptional() {
+		t.Error("max_price should be optional")
+	}
+}
+
+func TestDomainContactsResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	attrs := resourceSchema(t, namedotcom.NewDomainContactsResource()).Attributes
+
+	assertStringForcesReplace(t, attrs, "domain_name")
+
+	if !attrs["registrant"].IsRequired() {
+		t.Error("registrant should be required")
+	}
+
+	for _, role := range []string{"admin", "tech", "billing"} {
+		if !attrs[role].IsOptional() {
+			t.Errorf("%s should be optional, defaulting to the registrant", role)
+		}
+	}
+}
+
+func TestDomainTransferResource_Schema(t *testing.T) {
+	t.Parallel()
+
+	attrs := resourceSchema(t, namedotcom.NewDomainTransferResource()).Attributes
+
+	assertStringForcesReplace(t, attrs, "domain_name")
+
+	authCode := attrs["auth_code"]
+	if !authCode.IsWriteOnly() || !authCode.IsSensitive() {
+		t.Error("auth_code should be write-only and sensitive")
+	}
+
+	if !attrs["status"].IsComputed() {
+		t.Error("status should be computed")
+	}
+}
+
 func TestProviderResourcesInstantiate(t *testing.T) {
 	t.Parallel()
 
@@ -4,6 +4,9 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
@@ -21,6 +24,13 @@ func TestResourceMetadata(t *testing.T) {
 		{&recordResource{}, "namedotcom_record"},
 		{&dnssecResource{}, "namedotcom_dnssec"},
 		{&domainNameServersResource{}, "namedotcom_domain_nameservers"},
+		{&domainResource{}, "namedotcom_domain"},
+		{&domainAutorenewResource{}, "namedotcom_domain_autorenew"},
+		{&domainLockResource{}, "namedotcom_domain_lock"},
+		{&whoisPrivacyResource{}, "namedotcom_whois_privacy"},
+		{&domainContactsResource{}, "namedotcom_domain_contacts"},
+		{&domainTransferResource{}, "namedotcom_domain_transfer"},
+		{&dnssecKeysResource{}, "namedotcom_dnssec_keys"},
 	}
 
 	for _, testCase := range cases {
@@ -37,14 +47,18 @@ func TestResourceMetadata(t *testing.T) {
 func TestResourceConfigure(t *testing.T) {
 	t.Parallel()
 
-	client := &namecom.NameCom{}
+	data := newProviderData(&namecom.NameCom{}, true)
 
 	for _, res := range []resource.ResourceWithConfigure{
-		&recordResource{}, &dnssecResource{}, &domainNameServersResource{},
+		&recordResource{}, &dnssecResource{}, &domainNameServersResource{}, &domainResource{},
+		&domainAutorenewResource{}, &domainLockResource{}, &whoisPrivacyResource{},
+		&domainContactsResource{},
+		&domainTransferResource{},
+		&dnssecKeysResource{},
 	} {
 		var resp resource.ConfigureResponse
 
-		res.Configure(context.Background(), resource.ConfigureRequest{ProviderData: client}, &resp)
+		res.Configure(context.Background(), resource.ConfigureRequest{ProviderData: data}, &resp)
 
 		if resp.Diagnostics.HasError() {
 			t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
@@ -55,8 +69,86 @@ func TestResourceConfigure(t *testing.T) {
 func TestProviderDataSources(t *testing.T) {
 	t.Parallel()
 
-	if New("test")().DataSources(context.Background()) != nil {
-		t.Error("expected no data sources")
+	want := map[string]bool{
+		"namedotcom_zone_file":           true,
+		"namedotcom_domain_availability": true,
+		"namedotcom_domain_pricing":      true,
+		"namedotcom_domain_search":       true,
+		"namedotcom_orders":              true,
+		"namedotcom_order":               true,
+		"namedotcom_transfers":           true,
+		"namedotcom_expiring_domains":    true,
+		"namedotcom_dnssec":              true,
+	}
+
+	for _, factory := range New("test")().DataSources(context.Background()) {
+		var resp datasource.MetadataResponse
+
+		factory().Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "namedotcom"}, &resp)
+
+		if !want[resp.TypeName] {
+			t.Errorf("unexpected data source %q", resp.TypeName)
+		}
+
+		delete(want, resp.TypeName)
+	}
+
+	for name := range want {
+		t.Errorf("data source %q is not registered", name)
+	}
+}
+
+func TestProviderEphemeralResources(t *testing.T) {
+	t.Parallel()
+
+	want := map[string]bool{"namedotcom_domain_auth_code": true}
+
+	prov, ok := New("test")().(provider.ProviderWithEphemeralResources)
+	if !ok {
+		t.Fatal("provider does not implement ProviderWithEphemeralResources")
+	}
+
+	for _, factory := range prov.EphemeralResources(context.Background()) {
+		var resp ephemeral.MetadataResponse
+
+		factory().Metadata(context.Background(), ephemeral.MetadataRequest{ProviderTypeName: "namedotcom"}, &resp)
+
+		if !want[resp.TypeName] {
+			t.Errorf("unexpected ephemeral resource %q", resp.TypeName)
+		}
+
+		delete(want, resp.TypeName)
+	}
+
+	for name := range want {
+		t.Errorf("ephemeral resource %q is not registered", name)
+	}
+}
+
+func TestProviderActions(t *testing.T) {
+	t.Parallel()
+
+	want := map[string]bool{"namedotcom_renew_domain": true}
+
+	prov, ok := New("test")().(provider.ProviderWithActions)
+	if !ok {
+		t.Fatal("provider does not implement ProviderWithActions")
+	}
+
+	for _, factory := range prov.Actions(context.Background()) {
+		var resp action.MetadataResponse
+
+		factory().Metadata(context.Background(), action.MetadataRequest{ProviderTypeName: "namedotcom"}, &resp)
+
+		if !want[resp.TypeName] {
+			t.Errorf("unexpected action %q", resp.TypeName)
+		}
+
+		delete(want, resp.TypeName)
+	}
+
+	for name := range want {
+		t.Errorf("action %q is not registered", name)
 	}
 }
 
@@ -75,6 +167,10 @@ func TestProviderConfigure_EnvFallback(t *testing.T) {
 	if resp.ResourceData == nil {
 		t.Error("expected a configured client in ResourceData")
 	}
+
+	if resp.EphemeralResourceData != resp.ResourceData || resp.ActionData != resp.ResourceData {
+		t.Error("expected the configured client in EphemeralResourceData and ActionData")
+	}
 }
 
 func TestProviderConfigure_MissingCredentials(t *testing.T) {
@@ -101,6 +197,7 @@ func TestProviderConfigure_UnknownCredentialErrors(t *testing.T) {
 		RateLimitPerSecond: types.Int64Null(),
 		RateLimitPerHour:   types.Int64Null(),
 		Timeout:            types.Int64Null(),
+		RecordCache:        types.BoolNull(),
 	})
 
 	var resp provider.ConfigureResponse
@@ -112,6 +209,48 @@ func TestProviderConfigure_UnknownCredentialErrors(t *testing.T) {
 	}
 }
 
+// TestProviderConfigure_RecordCacheToggle confirms the record cache is on by
+// default and record_cache = false turns it off.
+//
+//nolint:paralleltest // Configure re-initializes the global rate limiter.
+func TestProviderConfigure_RecordCacheToggle(t *testing.T) {
+	cases := []struct {
+		name    string
+		setting types.Bool
+		want    bool
+	}{
+		{"default", types.BoolNull(), true},
+		{"enabled", types.BoolValue(true), true},
+		{"disabled", types.BoolValue(false), false},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := providerConfig(t, providerModel{
+				Username:           types.StringValue("user"),
+				Token:              types.StringValue("token"),
+				RateLimitPerSecond: types.Int64Null(),
+				RateLimitPerHour:   types.Int64Null(),
+				Timeout:            types.Int64Null(),
+				RecordCache:        testCase.setting,
+			})
+
+			var resp provider.ConfigureResponse
+
+			New("test")().Configure(context.Background(), provider.ConfigureRequest{Config: cfg}, &resp)
+
+			data, ok := resp.ResourceData.(*providerData)
+			if !ok {
+				t.Fatalf("ResourceData = %T, want *providerData", resp.ResourceData)
+			}
+
+			if data.records.enabled != testCase.want {
+				t.Errorf("record cache enabled = %v, want %v", data.records.enabled, testCase.want)
+			}
+		})
+	}
+}
+
 // nullProviderConfig builds a provider Config whose attributes are all null, so
 // Configure falls back to the environment.
 func nullProviderConfig(t *testing.T) tfsdk.Config {
@@ -123,6 +262,7 @@ func nullProviderConfig(t *testing.T) tfsdk.Config {
 		RateLimitPerSecond: types.Int64Null(),
 		RateLimitPerHour:   types.Int64Null(),
 		Timeout:            types.Int64Null(),
+		RecordCache:        types.BoolNull(),
 	})
 }
 
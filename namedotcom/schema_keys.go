@@ -10,16 +10,66 @@ const (
 	keyAnswer             = "answer"
 	keyPriority           = "priority"
 	keyRecordID           = "record_id"
+	keyAdoptExisting      = "adopt_existing"
 	keyKeyTag             = "key_tag"
 	keyAlgorithm          = "algorithm"
 	keyDigestType         = "digest_type"
 	keyDigest             = "digest"
 	keyNameservers        = "nameservers"
+	keyYears              = "years"
+	keyMaxPurchasePrice   = "max_purchase_price"
+	keyTLDRequirements    = "tld_requirements"
+	keyContacts           = "contacts"
+	keyPrivacyEnabled     = "privacy_enabled"
+	keyAutorenewEnabled   = "autorenew_enabled"
+	keyLocked             = "locked"
+	keyPurchasePrice      = "purchase_price"
+	keyPurchaseType       = "purchase_type"
+	keyPremium            = "premium"
+	keyRenewalPrice       = "renewal_price"
+	keyOrderID            = "order_id"
+	keyCreateDate         = "create_date"
+	keyExpireDate         = "expire_date"
+	keyEnabled            = "enabled"
+	keyOnDestroy          = "on_destroy"
+	keyPreventUnlock      = "prevent_unlock"
+	keyMaxPrice           = "max_price"
+	keyAuthCode           = "auth_code"
+	keyWaitForCompletion  = "wait_for_completion"
+	keyWaitTimeout        = "wait_timeout"
+	keyPollInterval       = "poll_interval"
+	keyStatus             = "status"
+	keyEmail              = "email"
+	keyTotalPaid          = "total_paid"
+	keyDomainNames        = "domain_names"
+	keyResults            = "results"
+	keyPurchasable        = "purchasable"
+	keyTransferPrice      = "transfer_price"
+	keyKeyword            = "keyword"
+	keyTLDFilter          = "tld_filter"
+	keyCreatedAfter       = "created_after"
+	keyCreatedBefore      = "created_before"
+	keyItemType           = "item_type"
+	keyOrders             = "orders"
+	keyTransfers          = "transfers"
+	keyDays               = "days"
+	keyNow                = "now"
+	keyDomains            = "domains"
+	keyRenewalPriceTotal  = "renewal_price_total"
+	keyKeys               = "keys"
+	keyRemoveUnmanaged    = "remove_unmanaged"
+	keyAllowSingleNS      = "allow_single_nameserver"
+	keyEffectiveNS        = "effective_nameservers"
+	keyUseAccountDefaults = "use_account_defaults"
+	keyImportID           = "import_id"
+	keyContent            = "content"
+	keyTTL                = "ttl"
 	keyToken              = "token"
 	keyUsername           = "username"
 	keyRateLimitPerSecond = "rate_limit_per_second"
 	keyRateLimitPerHour   = "rate_limit_per_hour"
 	keyTimeout            = "timeout"
+	keyRecordCache        = "record_cache"
 )
 
 // descIDIsDomainName is the shared description for the computed id attribute of
@@ -0,0 +1,81 @@
+package namedotcom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// TestDomainSearchRead confirms the keyword, TLD filter and timeout are sent,
+// and the results come back sorted by domain name without repeats.
+//
+//nolint:paralleltest // Exercises the global rate limiter.
+func TestDomainSearchRead(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	var request namecom.SearchRequest
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains:search", func(writer http.ResponseWriter, req *http.Request) {
+		json.NewDecoder(req.Body).Decode(&request)
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"results":[`+
+			`{"domainName":"widget.net","purchasable":true,"purchasePrice":14.99},`+
+			`{"domainName":"widget.com","premium":true,"purchasePrice":2500},`+
+			`{"domainName":"getwidget.com","purchasable":true,"purchasePrice":12.99},`+
+			`{"domainName":"widget.net","purchasable":true,"purchasePrice":14.99}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	src := &domainSearchDataSource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp datasource.SchemaResponse
+
+	src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	tlds, _ := types.ListValueFrom(context.Background(), types.StringType, []string{"com", "net"})
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	config.Set(context.Background(), &domainSearchModel{
+		Keyword:   types.StringValue("widget"),
+		TLDFilter: tlds,
+		Timeout:   types.Int32Value(2000),
+	})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(config)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if request.Keyword != "widget" || request.Timeout != 2000 || !slices.Equal(request.TldFilter, []string{"com", "net"}) {
+		t.Errorf("Search request = %+v, want the configured keyword, TLDs and timeout", request)
+	}
+
+	var got domainSearchModel
+
+	resp.State.Get(context.Background(), &got)
+
+	names := make([]string, 0, len(got.Results))
+	for _, result := range got.Results {
+		names = append(names, result.DomainName.ValueString())
+	}
+
+	if want := []string{"getwidget.com", "widget.com", "widget.net"}; !slices.Equal(names, want) {
+		t.Errorf("results = %v, want %v", names, want)
+	}
+}
@@ -0,0 +1,380 @@
+//nolint:paralleltest // The transfer tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// transferServer serves the transfer of example.com, reporting each status in
+// statuses in turn and then the last one, and records the requests it gets.
+type transferServer struct {
+	statuses []string
+	reads    int
+	request  *namecom.CreateTransferRequest
+	canceled bool
+}
+
+func (s *transferServer) client(t *testing.T) *namecom.NameCom {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/transfers", func(writer http.ResponseWriter, request *http.Request) {
+		s.request = &namecom.CreateTransferRequest{}
+		json.NewDecoder(request.Body).Decode(s.request)
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"transfer":{"domainName":"example.com","status":%q},"order":42,"totalPaid":12.99}`, s.statuses[0])
+	})
+	mux.HandleFunc("/v4/transfers/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		status := s.statuses[min(s.reads, len(s.statuses)-1)]
+		s.reads++
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"domainName":"example.com","email":"owner@example.com","status":%q}`, status)
+	})
+	mux.HandleFunc("/v4/transfers/example.com:cancel", func(writer http.ResponseWriter, _ *http.Request) {
+		s.canceled = true
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domainName":"example.com","status":"Canceled"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return namecom.Mock("u", "t", server.URL)
+}
+
+// transferModel returns the model of a transfer of example.com with the
+// schema defaults.
+func transferModel() domainTransferModel {
+	return domainTransferModel{
+		ID:                types.StringUnknown(),
+		DomainName:        hostnameValue{StringValue: types.StringValue("example.com")},
+		AuthCode:          types.StringNull(),
+		PrivacyEnabled:    types.BoolValue(false),
+		PurchasePrice:     types.Float64Null(),
+		WaitForCompletion: types.BoolValue(false),
+		WaitTimeout:       types.Int32Value(defaultTransferWaitTimeout),
+		PollInterval:      types.Int32Value(defaultTransferPollInterval),
+		Status:            types.StringUnknown(),
+		Email:             types.StringUnknown(),
+		OrderID:           types.Int32Unknown(),
+		TotalPaid:         types.Float64Unknown(),
+	}
+}
+
+// TestDomainTransferCreate_AuthCode confirms the auth code is read from the
+// configuration and sent to Name.com, but never written to state.
+func TestDomainTransferCreate_AuthCode(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &transferServer{statuses: []string{"Pending Transfer Approval"}}
+	res := &domainTransferResource{client: server.client(t)}
+
+	var schemaResp resource.SchemaResponse
+
+	res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	plan.Set(context.Background(), transferModel())
+
+	configModel := transferModel()
+	configModel.AuthCode = types.StringValue("s3cret")
+	configState := tfsdk.State{Schema: schemaResp.Schema}
+	configState.Set(context.Background(), configModel)
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	res.Create(context.Background(), resource.CreateRequest{Plan: plan, Config: tfsdk.Config(configState)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if server.request == nil || server.request.AuthCode != "s3cret" {
+		t.Fatalf("CreateTransfer request = %+v, want the configured auth code", server.request)
+	}
+
+	var got domainTransferModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if !got.AuthCode.IsNull() {
+		t.Error("auth_code must not be stored in state")
+	}
+
+	if got.Status.ValueString() != "Pending Transfer Approval" || got.OrderID.ValueInt32() != 42 {
+		t.Errorf("status = %v, order_id = %v, want the created transfer", got.Status, got.OrderID)
+	}
+}
+
+// pendingTransferState returns the state of a pending transfer of example.com
+// requested without waiting.
+func pendingTransferState(t *testing.T, res *domainTransferResource, status string) tfsdk.State {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+
+	res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	model := transferModel()
+	model.ID = types.StringValue("example.com")
+	model.Status = types.StringValue(status)
+	model.Email = types.StringValue("")
+	model.OrderID = types.Int32Value(42)
+	model.TotalPaid = types.Float64Value(12.99)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+
+	diags := state.Set(context.Background(), model)
+	if diags.HasError() {
+		t.Fatalf("building transfer state: %v", diags)
+	}
+
+	return state
+}
+
+// TestDomainTransferModifyPlan_Wait confirms turning on wait_for_completion
+// for a pending transfer plans status and email as unknown, since the update
+// rewrites them, and leaves a finished transfer's values alone.
+func TestDomainTransferModifyPlan_Wait(t *testing.T) {
+	for status, wantUnknown := range map[string]bool{
+		"Pending Transfer Approval": true,
+		"Completed":                 false,
+	} {
+		res := &domainTransferResource{}
+		state := pendingTransferState(t, res, status)
+
+		plan := tfsdk.Plan(state)
+		plan.SetAttribute(context.Background(), path.Root(keyWaitForCompletion), true)
+
+		resp := resource.ModifyPlanResponse{Plan: plan}
+
+		res.ModifyPlan(context.Background(), resource.ModifyPlanRequest{State: state, Plan: plan}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", status, resp.Diagnostics)
+		}
+
+		var got domainTransferModel
+
+		resp.Plan.Get(context.Background(), &got)
+
+		if got.Status.IsUnknown() != wantUnknown || got.Email.IsUnknown() != wantUnknown {
+			t.Errorf("%s: status = %v, email = %v, want unknown = %v", status, got.Status, got.Email, wantUnknown)
+		}
+	}
+}
+
+// TestDomainTransferUpdate_Wait confirms an update that turns on waiting polls
+// the transfer and stores the status it ends with.
+func TestDomainTransferUpdate_Wait(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &transferServer{statuses: []string{"Completed"}}
+	res := &domainTransferResource{client: server.client(t)}
+	state := pendingTransferState(t, res, "Pending Transfer Approval")
+
+	plan := tfsdk.Plan(state)
+	plan.SetAttribute(context.Background(), path.Root(keyWaitForCompletion), true)
+	plan.SetAttribute(context.Background(), path.Root(keyStatus), types.StringUnknown())
+	plan.SetAttribute(context.Background(), path.Root(keyEmail), types.StringUnknown())
+
+	resp := resource.UpdateResponse{State: state}
+
+	res.Update(context.Background(), resource.UpdateRequest{State: state, Plan: plan}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got domainTransferModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.Status.ValueString() != "Completed" || got.Email.ValueString() != "owner@example.com" || server.reads != 1 {
+		t.Errorf("status = %v, email = %v after %d reads, want Completed from one read", got.Status, got.Email, server.reads)
+	}
+}
+
+// TestDomainTransferCreate_WaitReadFailure confirms a failure to read the
+// transfer after it was requested is only a warning: an error would taint the
+// resource, and the next apply would cancel the paid transfer.
+func TestDomainTransferCreate_WaitReadFailure(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/transfers", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"transfer":{"domainName":"example.com","status":"Pending Transfer Approval"},"order":42,"totalPaid":12.99}`)
+	})
+	mux.HandleFunc("/v4/transfers/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		writer.WriteHeader(http.StatusInternalServerError)
+		fmt.Fprint(writer, `{"message":"Internal Server Error"}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	res := &domainTransferResource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp resource.SchemaResponse
+
+	res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	model := transferModel()
+	model.WaitForCompletion = types.BoolValue(true)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	plan.Set(context.Background(), model)
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	res.Create(context.Background(), resource.CreateRequest{Plan: plan, Config: tfsdk.Config(plan)}, &resp)
+
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 {
+		t.Fatalf("diagnostics = %v, want a single warning", resp.Diagnostics)
+	}
+
+	var got domainTransferModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.Status.ValueString() != "Pending Transfer Approval" || got.OrderID.ValueInt32() != 42 {
+		t.Errorf("status = %v, order_id = %v, want the requested transfer", got.Status, got.OrderID)
+	}
+}
+
+// TestWaitForTransfer confirms polling stops at a final status, and reports a
+// deadline when the transfer is still pending after the timeout.
+func TestWaitForTransfer(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &transferServer{statuses: []string{"Pending Transfer Approval", "Pending Transfer Approval", "Completed"}}
+
+	transfer, err := waitForTransfer(context.Background(), server.client(t), "example.com", time.Millisecond, time.Minute)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if transfer.Status != "Completed" || server.reads != 3 {
+		t.Errorf("status = %q after %d reads, want Completed after 3", transfer.Status, server.reads)
+	}
+
+	pending := &transferServer{statuses: []string{"Pending Transfer Approval"}}
+
+	transfer, err = waitForTransfer(context.Background(), pending.client(t), "example.com", time.Millisecond, 20*time.Millisecond)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want a deadline", err)
+	}
+
+	if transfer == nil || transfer.Status != "Pending Transfer Approval" {
+		t.Errorf("transfer = %+v, want the last pending status", transfer)
+	}
+}
+
+// TestDomainTransferDelete confirms destroying cancels a pending transfer and
+// leaves a finished one alone.
+func TestDomainTransferDelete(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	for status, wantCancel := range map[string]bool{
+		"Pending Transfer Approval": true,
+		"Completed":                 false,
+		"Rejected":                  false,
+	} {
+		server := &transferServer{statuses: []string{status}}
+		res := &domainTransferResource{client: server.client(t)}
+
+		var schemaResp resource.SchemaResponse
+
+		res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+		model := transferModel()
+		model.ID = types.StringValue("example.com")
+		model.Status = types.StringValue(status)
+		model.Email = types.StringValue("")
+		model.OrderID = types.Int32Value(42)
+		model.TotalPaid = types.Float64Value(12.99)
+
+		state := tfsdk.State{Schema: schemaResp.Schema}
+		state.Set(context.Background(), model)
+
+		var resp resource.DeleteResponse
+
+		res.Delete(context.Background(), resource.DeleteRequest{State: state}, &resp)
+
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("%s: unexpected diagnostics: %v", status, resp.Diagnostics)
+		}
+
+		if server.canceled != wantCancel {
+			t.Errorf("%s: canceled = %v, want %v", status, server.canceled, wantCancel)
+		}
+	}
+}
+
+// TestTransfersRead confirms the status filter.
+func TestTransfersRead(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	requests := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/transfers", func(writer http.ResponseWriter, _ *http.Request) {
+		requests++
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"transfers":[`+
+			`{"domainName":"example.com","email":"owner@example.com","status":"Pending Transfer Approval"},`+
+			`{"domainName":"example.net","status":"Rejected"}],"lastPage":1}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	src := &transfersDataSource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp datasource.SchemaResponse
+
+	src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(context.Background(), transfersModel{Status: types.StringValue("rejected")})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(state)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got transfersModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if len(got.Transfers) != 1 || got.Transfers[0].DomainName.ValueString() != "example.net" {
+		t.Errorf("transfers = %+v, want only the rejected example.net", got.Transfers)
+	}
+
+	if requests != 1 {
+		t.Errorf("ListTransfers called %d times, want 1", requests)
+	}
+}
@@ -0,0 +1,215 @@
+//nolint:paralleltest // The Whois privacy tests exercise the global rate limiter.
+package namedotcom
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// privacyServer serves example.com without Whois privacy: enabling it fails
+// until it is bought, and the purchase request is recorded.
+type privacyServer struct {
+	owned    bool
+	purchase *namecom.PrivacyRequest
+	// enableStatus and enableBody, when set, replace the answer to
+	// :enableWhoisPrivacy.
+	enableStatus int
+	enableBody   string
+}
+
+func (s *privacyServer) client(t *testing.T) *namecom.NameCom {
+	t.Helper()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(writer, `{"domainName":"example.com","privacyEnabled":%t}`, s.owned)
+	})
+	mux.HandleFunc("/v4/domains/example.com:enableWhoisPrivacy", func(writer http.ResponseWriter, _ *http.Request) {
+		if s.enableStatus != 0 {
+			http.Error(writer, s.enableBody, s.enableStatus)
+
+			return
+		}
+
+		http.Error(writer, `{"message":"Whois privacy is not purchased"}`, http.StatusBadRequest)
+	})
+	mux.HandleFunc("/v4/domains/example.com:purchasePrivacy", func(writer http.ResponseWriter, request *http.Request) {
+		s.purchase = &namecom.PrivacyRequest{}
+		json.NewDecoder(request.Body).Decode(s.purchase)
+		s.owned = true
+
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"domain":{"domainName":"example.com","privacyEnabled":true},"order":7,"totalPaid":4.99}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	return namecom.Mock("u", "t", server.URL)
+}
+
+// createPrivacy runs Create for example.com with privacy enabled and returns
+// the response.
+func createPrivacy(t *testing.T, res *whoisPrivacyResource, maxPrice types.Float64) resource.CreateResponse {
+	t.Helper()
+
+	var schemaResp resource.SchemaResponse
+
+	res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	plan.Set(context.Background(), &whoisPrivacyModel{
+		ID:         types.StringUnknown(),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Enabled:    types.BoolValue(true),
+		MaxPrice:   maxPrice,
+		Years:      types.Int32Value(2),
+		OnDestroy:  types.StringValue(onDestroyKeep),
+	})
+
+	resp := resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	res.Create(context.Background(), resource.CreateRequest{Plan: plan}, &resp)
+
+	return resp
+}
+
+// TestWhoisPrivacyCreate_Purchase confirms privacy the domain does not have is
+// bought with max_price as the expected price and the configured years.
+func TestWhoisPrivacyCreate_Purchase(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &privacyServer{}
+	resp := createPrivacy(t, &whoisPrivacyResource{client: server.client(t)}, types.Float64Value(5))
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if server.purchase == nil || server.purchase.PurchasePrice != 5 || server.purchase.Years != 2 {
+		t.Fatalf("PurchasePrivacy request = %+v, want price 5 for 2 years", server.purchase)
+	}
+
+	var got whoisPrivacyModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if !got.Enabled.ValueBool() {
+		t.Error("enabled = false after the purchase")
+	}
+}
+
+// TestWhoisPrivacyCreate_NoGuard confirms nothing is bought without max_price.
+func TestWhoisPrivacyCreate_NoGuard(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &privacyServer{}
+	resp := createPrivacy(t, &whoisPrivacyResource{client: server.client(t)}, types.Float64Null())
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("expected an error without max_price")
+	}
+
+	withPath, ok := resp.Diagnostics[0].(diag.DiagnosticWithPath)
+	if !ok || !withPath.Path().Equal(path.Root(keyMaxPrice)) {
+		t.Errorf("diagnostic not attached to max_price: %v", resp.Diagnostics[0])
+	}
+
+	if server.purchase != nil {
+		t.Error("PurchasePrivacy must not be called without max_price")
+	}
+}
+
+// TestWhoisPrivacyCreate_NoPurchaseOnOtherErrors confirms only an answer that
+// privacy was never bought leads to a purchase: outages, authentication
+// failures and answers that do not come from the API are reported as they are.
+func TestWhoisPrivacyCreate_NoPurchaseOnOtherErrors(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	testCases := []struct {
+		name   string
+		status int
+		body   string
+	}{
+		{"server error", http.StatusInternalServerError, `{"message":"Internal Server Error"}`},
+		{"unauthenticated", http.StatusUnauthorized, `{"message":"Unauthenticated"}`},
+		{"rate limited", http.StatusTooManyRequests, `{"message":"Too Many Requests"}`},
+		{"not JSON", http.StatusBadGateway, `<html>Bad Gateway</html>`},
+	}
+
+	for _, testCase := range testCases {
+		server := &privacyServer{enableStatus: testCase.status, enableBody: testCase.body}
+		resp := createPrivacy(t, &whoisPrivacyResource{client: server.client(t)}, types.Float64Value(5))
+
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("%s: expected the error to be reported", testCase.name)
+		}
+
+		if server.purchase != nil {
+			t.Errorf("%s: PurchasePrivacy must not be called", testCase.name)
+		}
+	}
+}
+
+// TestWhoisPrivacyCreate_AlreadyEnabled confirms nothing is bought when the
+// domain shows privacy is already on, whatever enabling it answered.
+func TestWhoisPrivacyCreate_AlreadyEnabled(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	server := &privacyServer{owned: true}
+	resp := createPrivacy(t, &whoisPrivacyResource{client: server.client(t)}, types.Float64Value(5))
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if server.purchase != nil {
+		t.Error("PurchasePrivacy must not be called for a domain that has privacy")
+	}
+}
+
+// TestWhoisPrivacyRead_Drift confirms privacy turned off outside Terraform is
+// reported, so the next apply turns it back on.
+func TestWhoisPrivacyRead_Drift(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	res := &whoisPrivacyResource{client: (&privacyServer{}).client(t)}
+
+	var schemaResp resource.SchemaResponse
+
+	res.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	state.Set(context.Background(), &whoisPrivacyModel{
+		ID:         types.StringValue("example.com"),
+		DomainName: hostnameValue{StringValue: types.StringValue("example.com")},
+		Enabled:    types.BoolValue(true),
+		MaxPrice:   types.Float64Null(),
+		Years:      types.Int32Value(1),
+		OnDestroy:  types.StringValue(onDestroyKeep),
+	})
+
+	resp := resource.ReadResponse{State: state}
+
+	res.Read(context.Background(), resource.ReadRequest{State: state}, &resp)
+
+	var got whoisPrivacyModel
+
+	resp.State.Get(context.Background(), &got)
+
+	if got.Enabled.ValueBool() {
+		t.Error("enabled = true, want the drifted false")
+	}
+}
@@ -0,0 +1,692 @@
+package namedotcom
+
+import (
+	"fmt"
+	"net/netip"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cockroachdb/errors"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+// recordTypeSOA is accepted in a zone file but skipped: Name.com generates the
+// SOA record of every zone it hosts, so it cannot be managed as a record.
+const recordTypeSOA = "SOA"
+
+// defaultRecordTTL is the TTL Name.com assigns when none is given; it is also
+// the TTL used for zone file entries that carry neither a TTL nor a $TTL.
+const defaultRecordTTL = 300
+
+// txtChunkLength is the longest character-string a TXT RDATA may hold
+// (RFC 1035 section 3.3); longer answers are rendered as several strings.
+const txtChunkLength = 255
+
+// zoneRecord is a single resource record in the shape of recordModel: the host
+// is relative to the zone ("" for the apex) and the answer uses the same form
+// the record resource accepts. Priority is only meaningful for MX and SRV.
+type zoneRecord struct {
+	Host        string
+	RecordType  string
+	Answer      string
+	TTL         uint32
+	Priority    uint32
+	HasPriority bool
+}
+
+// supportedRecordType reports whether Name.com can store records of this type.
+func supportedRecordType(recordType string) bool {
+	switch recordType {
+	case recordTypeA, recordTypeAAAA, recordTypeANAME, recordTypeCNAME,
+		recordTypeMX, recordTypeNS, recordTypeSRV, recordTypeTXT:
+		return true
+	default:
+		return false
+	}
+}
+
+// renderZoneFile renders the records of a zone as an RFC 1035 master file. The
+// output is canonical: records are sorted, names are written relative to the
+// $ORIGIN with "@" for the apex, targets are fully qualified, and every record
+// carries an explicit TTL and class, so the same records always render to the
+// same text.
+func renderZoneFile(domainName string, records []*namecom.Record) string {
+	origin := strings.ToLower(strings.TrimSuffix(domainName, "."))
+
+	sorted := make([]*namecom.Record, len(records))
+	copy(sorted, records)
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		left, right := sorted[i], sorted[j]
+
+		if hostKey(left.Host) != hostKey(right.Host) {
+			return hostKey(left.Host) < hostKey(right.Host)
+		}
+
+		if !strings.EqualFold(left.Type, right.Type) {
+			return strings.ToUpper(left.Type) < strings.ToUpper(right.Type)
+		}
+
+		if left.Priority != right.Priority {
+			return left.Priority < right.Priority
+		}
+
+		return left.Answer < right.Answer
+	})
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "$ORIGIN %s.\n", origin)
+
+	for _, record := range sorted {
+		owner := hostKey(record.Host)
+		if owner == "" {
+			owner = "@"
+		}
+
+		ttl := record.TTL
+		if ttl == 0 {
+			ttl = defaultRecordTTL
+		}
+
+		recordType := strings.ToUpper(record.Type)
+
+		fmt.Fprintf(&builder, "%s\t%d\tIN\t%s\t%s\n", owner, ttl, recordType, renderRData(recordType, record))
+	}
+
+	return builder.String()
+}
+
+// hostKey lower-cases a host and maps the apex forms "@" and "" to "".
+func hostKey(host string) string {
+	return strings.ToLower(strings.TrimSuffix(normalizeHost(host), "."))
+}
+
+// renderRData renders the RDATA column of a record in master-file syntax.
+func renderRData(recordType string, record *namecom.Record) string {
+	switch recordType {
+	case recordTypeCNAME, recordTypeANAME, recordTypeNS:
+		return fqdn(record.Answer)
+	case recordTypeMX:
+		return fmt.Sprintf("%d %s", record.Priority, fqdn(record.Answer))
+	case recordTypeSRV:
+		// Name.com stores the SRV priority separately and the remaining
+		// "weight port target" fields in the answer.
+		fields := strings.Fields(record.Answer)
+		if len(fields) == 3 { //nolint:mnd // weight, port and target
+			fields[2] = fqdn(fields[2])
+		}
+
+		return fmt.Sprintf("%d %s", record.Priority, strings.Join(fields, " "))
+	case recordTypeTXT:
+		return quoteTXT(record.Answer)
+	default:
+		return record.Answer
+	}
+}
+
+// fqdn returns a domain name with exactly one trailing dot.
+func fqdn(name string) string {
+	return strings.TrimSuffix(name, ".") + "."
+}
+
+// quoteTXT renders TXT text as one or more quoted character-strings, escaping
+// quotes and backslashes and splitting at the 255-byte limit per string.
+func quoteTXT(text string) string {
+	chunks := make([]string, 0, len(text)/txtChunkLength+1)
+
+	for len(text) > txtChunkLength {
+		chunks = append(chunks, text[:txtChunkLength])
+		text = text[txtChunkLength:]
+	}
+
+	chunks = append(chunks, text)
+
+	for i, chunk := range chunks {
+		escaped := strings.ReplaceAll(chunk, `\`, `\\`)
+		chunks[i] = `"` + strings.ReplaceAll(escaped, `"`, `\"`) + `"`
+	}
+
+	return strings.Join(chunks, " ")
+}
+
+// zoneToken is a single lexical token of a zone file entry.
+type zoneToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneEntry is one logical zone file entry: a directive or a resource record,
+// possibly spanning several physical lines inside parentheses.
+type zoneEntry struct {
+	line   int
+	tokens []zoneToken
+	// inherit is set when the entry starts with whitespace, meaning the owner
+	// name is omitted and the previous record's owner applies.
+	inherit bool
+}
+
+// zoneParser carries the state that directives and earlier entries establish
+// for the entries that follow them.
+type zoneParser struct {
+	zone      string
+	origin    string
+	lastOwner string
+	// ttl applies to records without an explicit TTL: the $TTL value when one
+	// was given, otherwise the last explicit TTL (RFC 1035 section 5.1).
+	ttl      uint32
+	ttlFixed bool
+	problems []string
+}
+
+// parseZoneFile parses an RFC 1035 master file for the given zone into the
+// records Name.com can store. $ORIGIN and $TTL directives, parentheses,
+// comments, quoted strings, omitted owners and TTL unit suffixes are
+// supported. SOA records are skipped; any other type Name.com cannot store, a
+// $INCLUDE, a non-IN class or malformed RDATA is reported with its line number.
+// All problems are collected so a single run lists every offending line.
+func parseZoneFile(content, zone string) ([]zoneRecord, error) {
+	zone = strings.ToLower(strings.TrimSuffix(strings.TrimSpace(zone), "."))
+	if zone == "" {
+		return nil, errors.New("zone name must not be empty")
+	}
+
+	entries, err := splitZoneEntries(content)
+	if err != nil {
+		return nil, err
+	}
+
+	parser := &zoneParser{zone: zone, origin: zone, ttl: defaultRecordTTL}
+
+	var records []zoneRecord
+
+	for _, entry := range entries {
+		record, ok := parser.parseEntry(entry)
+		if ok {
+			records = append(records, record)
+		}
+	}
+
+	if len(parser.problems) > 0 {
+		return nil, errors.Newf("invalid zone file:\n%s", strings.Join(parser.problems, "\n"))
+	}
+
+	return records, nil
+}
+
+// splitZoneEntries tokenizes a zone file into logical entries, joining the
+// lines enclosed in parentheses and dropping comments and blank lines.
+func splitZoneEntries(content string) ([]zoneEntry, error) {
+	var (
+		entries []zoneEntry
+		current *zoneEntry
+		depth   int
+	)
+
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+
+	for index, line := range lines {
+		lineNumber := index + 1
+
+		if current == nil {
+			current = &zoneEntry{
+				line:    lineNumber,
+				inherit: line != "" && (line[0] == ' ' || line[0] == '\t'),
+			}
+		}
+
+		tokens, delta, err := tokenizeZoneLine(line)
+		if err != nil {
+			return nil, errors.Newf("line %d: %s", lineNumber, err)
+		}
+
+		current.tokens = append(current.tokens, tokens...)
+		depth += delta
+
+		if depth < 0 {
+			return nil, errors.Newf("line %d: unbalanced closing parenthesis", lineNumber)
+		}
+
+		if depth > 0 {
+			continue
+		}
+
+		if len(current.tokens) > 0 {
+			entries = append(entries, *current)
+		}
+
+		current = nil
+	}
+
+	if depth > 0 {
+		return nil, errors.Newf("line %d: unclosed parenthesis", current.line)
+	}
+
+	return entries, nil
+}
+
+// tokenizeZoneLine splits one physical line into tokens, returning the net
+// change in parenthesis depth. A ';' outside a quoted string starts a comment.
+func tokenizeZoneLine(line string) ([]zoneToken, int, error) {
+	var (
+		tokens  []zoneToken
+		current strings.Builder
+		inToken bool
+		delta   int
+	)
+
+	flush := func() {
+		if inToken {
+			tokens = append(tokens, zoneToken{text: current.String()})
+			current.Reset()
+			inToken = false
+		}
+	}
+
+	for i := 0; i < len(line); i++ {
+		char := line[i]
+
+		switch {
+		case char == ';':
+			flush()
+
+			return tokens, delta, nil
+		case char == ' ' || char == '\t':
+			flush()
+		case char == '(':
+			flush()
+
+			delta++
+		case char == ')':
+			flush()
+
+			delta--
+		case char == '"':
+			flush()
+
+			text, next, err := readQuoted(line, i+1)
+			if err != nil {
+				return nil, 0, err
+			}
+
+			tokens = append(tokens, zoneToken{text: text, quoted: true})
+			i = next
+		case char == '\\' && i+1 < len(line):
+			current.WriteByte(char)
+			current.WriteByte(line[i+1])
+
+			inToken = true
+			i++
+		default:
+			current.WriteByte(char)
+
+			inToken = true
+		}
+	}
+
+	flush()
+
+	return tokens, delta, nil
+}
+
+// readQuoted reads a quoted character-string starting just after the opening
+// quote, resolving \X and \DDD escapes. It returns the text and the index of
+// the closing quote.
+func readQuoted(line string, start int) (string, int, error) {
+	var text strings.Builder
+
+	for i := start; i < len(line); i++ {
+		switch line[i] {
+		case '"':
+			return text.String(), i, nil
+		case '\\':
+			if i+3 < len(line) && isDigits(line[i+1:i+4]) {
+				value, _ := strconv.Atoi(line[i+1 : i+4])
+				if value > 255 { //nolint:mnd // largest byte value
+					return "", 0, errors.Newf("invalid escape \\%s", line[i+1:i+4])
+				}
+
+				text.WriteByte(byte(value))
+
+				i += 3
+
+				continue
+			}
+
+			if i+1 < len(line) {
+				i++
+				text.WriteByte(line[i])
+			}
+		default:
+			text.WriteByte(line[i])
+		}
+	}
+
+	return "", 0, errors.New("unterminated quoted string")
+}
+
+func isDigits(text string) bool {
+	for _, char := range text {
+		if char < '0' || char > '9' {
+			return false
+		}
+	}
+
+	return text != ""
+}
+
+// parseEntry handles a directive or a resource record. It returns a record and
+// true only for records Name.com can store; problems are recorded on the parser.
+func (p *zoneParser) parseEntry(entry zoneEntry) (zoneRecord, bool) {
+	tokens := entry.tokens
+
+	if !entry.inherit && !tokens[0].quoted && strings.HasPrefix(tokens[0].text, "$") {
+		p.parseDirective(entry)
+
+		return zoneRecord{}, false
+	}
+
+	owner := p.lastOwner
+
+	if !entry.inherit {
+		name, err := p.absoluteName(tokens[0].text)
+		if err != nil {
+			p.addProblem(entry.line, err.Error())
+
+			return zoneRecord{}, false
+		}
+
+		owner = name
+		tokens = tokens[1:]
+	}
+
+	if owner == "" {
+		p.addProblem(entry.line, "record has no owner name and no previous owner to inherit")
+
+		return zoneRecord{}, false
+	}
+
+	p.lastOwner = owner
+
+	ttl, recordType, rdata, err := p.splitRecord(tokens)
+	if err != nil {
+		p.addProblem(entry.line, err.Error())
+
+		return zoneRecord{}, false
+	}
+
+	if recordType == recordTypeSOA {
+		return zoneRecord{}, false
+	}
+
+	if !supportedRecordType(recordType) {
+		p.addProblem(entry.line, fmt.Sprintf(
+			"record type %s is not supported by Name.com (supported: A, AAAA, ANAME, CNAME, MX, NS, SRV, TXT)", recordType))
+
+		return zoneRecord{}, false
+	}
+
+	host, err := p.relativeHost(owner)
+	if err != nil {
+		p.addProblem(entry.line, err.Error())
+
+		return zoneRecord{}, false
+	}
+
+	record := zoneRecord{Host: host, RecordType: recordType, TTL: ttl}
+
+	err = p.parseRData(&record, rdata)
+	if err != nil {
+		p.addProblem(entry.line, fmt.Sprintf("%s record: %s", recordType, err))
+
+		return zoneRecord{}, false
+	}
+
+	return record, true
+}
+
+// parseDirective applies a $ORIGIN or $TTL directive and rejects the others.
+func (p *zoneParser) parseDirective(entry zoneEntry) {
+	directive := strings.ToUpper(entry.tokens[0].text)
+
+	switch directive {
+	case "$ORIGIN":
+		if len(entry.tokens) != 2 { //nolint:mnd // directive and its argument
+			p.addProblem(entry.line, "$ORIGIN takes exactly one domain name")
+
+			return
+		}
+
+		origin, err := p.absoluteName(entry.tokens[1].text)
+		if err != nil {
+			p.addProblem(entry.line, err.Error())
+
+			return
+		}
+
+		p.origin = origin
+	case "$TTL":
+		if len(entry.tokens) != 2 { //nolint:mnd // directive and its argument
+			p.addProblem(entry.line, "$TTL takes exactly one value")
+
+			return
+		}
+
+		ttl, err := parseTTL(entry.tokens[1].text)
+		if err != nil {
+			p.addProblem(entry.line, err.Error())
+
+			return
+		}
+
+		p.ttl = ttl
+		p.ttlFixed = true
+	default:
+		p.addProblem(entry.line, fmt.Sprintf("directive %s is not supported", directive))
+	}
+}
+
+// splitRecord separates the optional TTL and class (in either order) from the
+// type and RDATA of a resource record.
+func (p *zoneParser) splitRecord(tokens []zoneToken) (uint32, string, []zoneToken, error) {
+	ttl := p.ttl
+
+	for len(tokens) > 0 && !tokens[0].quoted {
+		field := strings.ToUpper(tokens[0].text)
+
+		switch {
+		case field == "IN":
+			tokens = tokens[1:]
+		case field == "CH" || field == "HS" || field == "CS":
+			return 0, "", nil, errors.Newf("class %s is not supported; only IN records can be stored", field)
+		case field != "" && field[0] >= '0' && field[0] <= '9':
+			parsed, err := parseTTL(field)
+			if err != nil {
+				return 0, "", nil, err
+			}
+
+			ttl = parsed
+			tokens = tokens[1:]
+
+			if !p.ttlFixed {
+				p.ttl = parsed
+			}
+		default:
+			return ttl, field, tokens[1:], nil
+		}
+	}
+
+	return 0, "", nil, errors.New("record has no type")
+}
+
+// parseTTL parses a TTL in seconds, accepting BIND unit suffixes such as 1h30m.
+func parseTTL(text string) (uint32, error) {
+	if value, err := strconv.ParseUint(text, 10, 32); err == nil {
+		return uint32(value), nil //nolint:gosec // ParseUint bounds the value to 32 bits.
+	}
+
+	units := map[rune]uint64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	var total, current uint64
+
+	digits := false
+
+	for _, char := range strings.ToLower(text) {
+		if char >= '0' && char <= '9' {
+			current = current*10 + uint64(char-'0')
+			digits = true
+
+			continue
+		}
+
+		multiplier, ok := units[char]
+		if !ok || !digits {
+			return 0, errors.Newf("invalid TTL %q", text)
+		}
+
+		total += current * multiplier
+		current, digits = 0, false
+	}
+
+	if digits {
+		return 0, errors.Newf("invalid TTL %q: missing unit after the last number", text)
+	}
+
+	if total > uint64(^uint32(0)) {
+		return 0, errors.Newf("TTL %q is out of range", text)
+	}
+
+	return uint32(total), nil //nolint:gosec // Range-checked above.
+}
+
+// absoluteName resolves a possibly relative name against $ORIGIN, returning it
+// lower-cased and without the trailing dot.
+func (p *zoneParser) absoluteName(name string) (string, error) {
+	switch {
+	case name == "@":
+		return p.origin, nil
+	case name == "" || strings.Contains(name, ".."):
+		return "", errors.Newf("invalid domain name %q", name)
+	case strings.HasSuffix(name, "."):
+		return strings.ToLower(strings.TrimSuffix(name, ".")), nil
+	default:
+		return strings.ToLower(name) + "." + p.origin, nil
+	}
+}
+
+// relativeHost converts an absolute owner name to a host relative to the zone.
+func (p *zoneParser) relativeHost(owner string) (string, error) {
+	if owner == p.zone {
+		return "", nil
+	}
+
+	if strings.HasSuffix(owner, "."+p.zone) {
+		return strings.TrimSuffix(owner, "."+p.zone), nil
+	}
+
+	return "", errors.Newf("owner name %s is outside zone %s", owner, p.zone)
+}
+
+// parseRData fills the answer (and priority) of a record from its RDATA.
+func (p *zoneParser) parseRData(record *zoneRecord, rdata []zoneToken) error {
+	switch record.RecordType {
+	case recordTypeA, recordTypeAAAA:
+		return parseAddressRData(record, rdata)
+	case recordTypeCNAME, recordTypeANAME, recordTypeNS:
+		if len(rdata) != 1 {
+			return errors.Newf("expected a single target name, got %d fields", len(rdata))
+		}
+
+		target, err := p.absoluteName(rdata[0].text)
+		if err != nil {
+			return err
+		}
+
+		record.Answer = target
+	case recordTypeMX:
+		if len(rdata) != 2 { //nolint:mnd // preference and exchange
+			return errors.Newf("expected preference and exchange, got %d fields", len(rdata))
+		}
+
+		return p.parsePriorityTarget(record, rdata[0].text, nil, rdata[1].text)
+	case recordTypeSRV:
+		if len(rdata) != 4 { //nolint:mnd // priority, weight, port and target
+			return errors.Newf("expected priority, weight, port and target, got %d fields", len(rdata))
+		}
+
+		return p.parsePriorityTarget(record, rdata[0].text, []string{rdata[1].text, rdata[2].text}, rdata[3].text)
+	case recordTypeTXT:
+		if len(rdata) == 0 {
+			return errors.New("expected at least one character-string")
+		}
+
+		var text strings.Builder
+
+		for _, token := range rdata {
+			text.WriteString(token.text)
+		}
+
+		record.Answer = text.String()
+	}
+
+	return nil
+}
+
+// parseAddressRData validates the address of an A or AAAA record.
+func parseAddressRData(record *zoneRecord, rdata []zoneToken) error {
+	if len(rdata) != 1 {
+		return errors.Newf("expected a single address, got %d fields", len(rdata))
+	}
+
+	addr, err := netip.ParseAddr(rdata[0].text)
+	if err != nil {
+		return errors.Newf("invalid address %q", rdata[0].text)
+	}
+
+	if record.RecordType == recordTypeA && !addr.Is4() {
+		return errors.Newf("%q is not an IPv4 address", rdata[0].text)
+	}
+
+	if record.RecordType == recordTypeAAAA && (!addr.Is6() || addr.Is4In6()) {
+		return errors.Newf("%q is not an IPv6 address", rdata[0].text)
+	}
+
+	record.Answer = addr.String()
+
+	return nil
+}
+
+// parsePriorityTarget handles the MX and SRV layouts: a 16-bit priority, any
+// further 16-bit fields (SRV weight and port) and a target name. Name.com keeps
+// the priority separately and the remaining fields, space separated, in the
+// answer.
+func (p *zoneParser) parsePriorityTarget(record *zoneRecord, priority string, fields []string, target string) error {
+	value, err := strconv.ParseUint(priority, 10, 16)
+	if err != nil {
+		return errors.Newf("invalid priority %q: must be 0-65535", priority)
+	}
+
+	for _, field := range fields {
+		_, err = strconv.ParseUint(field, 10, 16)
+		if err != nil {
+			return errors.Newf("invalid field %q: must be 0-65535", field)
+		}
+	}
+
+	name, err := p.absoluteName(target)
+	if err != nil {
+		return err
+	}
+
+	record.Priority = uint32(value) //nolint:gosec // ParseUint bounds the value to 16 bits.
+	record.HasPriority = true
+	record.Answer = strings.Join(append(fields, name), " ")
+
+	return nil
+}
+
+// addProblem records a line-numbered parse problem.
+func (p *zoneParser) addProblem(line int, message string) {
+	p.problems = append(p.problems, fmt.Sprintf("line %d: %s", line, message))
+}
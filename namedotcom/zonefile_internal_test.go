@@ -0,0 +1,328 @@
+package namedotcom
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/namedotcom/go/v4/namecom"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+; Name.com generates the SOA itself, so it is skipped.
+@	IN	SOA	ns1.name.com. hostmaster.example.com. (
+		2024010101 ; serial
+		3600 600 604800 300 )
+@		A	192.0.2.1
+		AAAA	2001:DB8::1
+www	300	IN	CNAME	@
+mail	IN	600	A	192.0.2.25
+@		MX	10 mail
+_sip._tcp	SRV	10 5 5060 sip.example.net.
+@		TXT	"v=spf1 " "-all"
+sub.example.com.	NS	ns1.dns.example.
+`
+
+// TestParseZoneFile covers the supported master-file syntax end to end: $ORIGIN
+// and $TTL, parentheses, comments, owner inheritance, TTL/class in either
+// order, relative and absolute names, and the MX/SRV priority split.
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	records, err := parseZoneFile(testZoneFile, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []zoneRecord{
+		{Host: "", RecordType: "A", Answer: "192.0.2.1", TTL: 3600},
+		{Host: "", RecordType: "AAAA", Answer: "2001:db8::1", TTL: 3600},
+		{Host: "www", RecordType: "CNAME", Answer: "example.com", TTL: 300},
+		{Host: "mail", RecordType: "A", Answer: "192.0.2.25", TTL: 600},
+		{Host: "", RecordType: "MX", Answer: "mail.example.com", TTL: 3600, Priority: 10, HasPriority: true},
+		{Host: "_sip._tcp", RecordType: "SRV", Answer: "5 5060 sip.example.net", TTL: 3600, Priority: 10, HasPriority: true},
+		{Host: "", RecordType: "TXT", Answer: "v=spf1 -all", TTL: 3600},
+		{Host: "sub", RecordType: "NS", Answer: "ns1.dns.example", TTL: 3600},
+	}
+
+	if len(records) != len(want) {
+		t.Fatalf("got %d records, want %d: %+v", len(records), len(want), records)
+	}
+
+	for i := range want {
+		if records[i] != want[i] {
+			t.Errorf("record %d = %+v, want %+v", i, records[i], want[i])
+		}
+	}
+}
+
+// TestParseZoneFile_LastExplicitTTL pins the RFC 1035 rule used when no $TTL is
+// present: a record without a TTL inherits the last explicit one.
+func TestParseZoneFile_LastExplicitTTL(t *testing.T) {
+	t.Parallel()
+
+	records, err := parseZoneFile("a 900 A 192.0.2.1\nb A 192.0.2.2\n", "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if records[1].TTL != 900 {
+		t.Errorf("ttl = %d, want the inherited 900", records[1].TTL)
+	}
+}
+
+// TestParseZoneFile_Errors confirms that every rejected line is reported with
+// its own line number, so a large file can be fixed in one pass.
+func TestParseZoneFile_Errors(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		content string
+		want    []string
+	}{
+		{
+			name:    "unsupported types are reported per line",
+			content: "@ A 192.0.2.1\n@ CAA 0 issue \"ca.example\"\nwww SSHFP 1 1 abcdef\n",
+			want:    []string{"line 2: record type CAA is not supported", "line 3: record type SSHFP is not supported"},
+		},
+		{name: "include directive", content: "$INCLUDE other.zone\n", want: []string{"line 1: directive $INCLUDE"}},
+		{name: "non-IN class", content: "@ CH A 192.0.2.1\n", want: []string{"line 1: class CH"}},
+		{name: "IPv6 in an A record", content: "@ A 2001:db8::1\n", want: []string{"line 1: A record", "not an IPv4 address"}},
+		{name: "IPv4 in an AAAA record", content: "@ AAAA 192.0.2.1\n", want: []string{"line 1: AAAA record"}},
+		{name: "MX without preference", content: "@ MX mail.example.com.\n", want: []string{"line 1: MX record"}},
+		{name: "SRV with a bad port", content: "_x._tcp SRV 1 1 99999 t.example.\n", want: []string{"line 1: SRV record"}},
+		{
+			name:    "owner outside the zone",
+			content: "www.example.net. A 192.0.2.1\n",
+			want:    []string{"line 1: owner name www.example.net is outside zone"},
+		},
+		{name: "unterminated string", content: "@ TXT \"open\n", want: []string{"line 1: unterminated quoted string"}},
+		{name: "unclosed parenthesis", content: "@ TXT ( \"a\"\n", want: []string{"line 1: unclosed parenthesis"}},
+		{name: "bad TTL", content: "@ 5x A 192.0.2.1\n", want: []string{"line 1: invalid TTL"}},
+		{name: "no type", content: "@ 300\n", want: []string{"line 1: record has no type"}},
+	}
+
+	for _, testCase := range cases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseZoneFile(testCase.content, "example.com")
+			if err == nil {
+				t.Fatal("expected an error, got nil")
+			}
+
+			for _, fragment := range testCase.want {
+				if !strings.Contains(err.Error(), fragment) {
+					t.Errorf("error %q does not mention %q", err.Error(), fragment)
+				}
+			}
+		})
+	}
+}
+
+func TestParseTTL(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		input   string
+		want    uint32
+		wantErr bool
+	}{
+		{input: "300", want: 300},
+		{input: "1h", want: 3600},
+		{input: "1h30m", want: 5400},
+		{input: "1W2D", want: 777600},
+		{input: "10", want: 10},
+		{input: "h", wantErr: true},
+		{input: "1h5", wantErr: true},
+		{input: "1y", wantErr: true},
+	}
+
+	for _, testCase := range cases {
+		got, err := parseTTL(testCase.input)
+
+		if (err != nil) != testCase.wantErr {
+			t.Errorf("parseTTL(%q) error = %v, wantErr %v", testCase.input, err, testCase.wantErr)
+
+			continue
+		}
+
+		if got != testCase.want {
+			t.Errorf("parseTTL(%q) = %d, want %d", testCase.input, got, testCase.want)
+		}
+	}
+}
+
+// TestRenderZoneFile pins the canonical output: sorted records, "@" for the
+// apex, explicit TTL and class, fully qualified targets, the MX/SRV priority
+// folded back into the RDATA, and quoted TXT.
+func TestRenderZoneFile(t *testing.T) {
+	t.Parallel()
+
+	records := []*namecom.Record{
+		{ID: 3, Host: "www", Type: "CNAME", Answer: "example.com", TTL: 300},
+		{ID: 5, Host: "_sip._tcp", Type: "SRV", Answer: "5 5060 sip.example.net", TTL: 300, Priority: 10},
+		{ID: 1, Host: "", Type: "A", Answer: "192.0.2.1", TTL: 0},
+		{ID: 4, Host: "", Type: "MX", Answer: "mail.example.com.", TTL: 300, Priority: 10},
+		{ID: 2, Host: "", Type: "TXT", Answer: `say "hi"`, TTL: 300},
+	}
+
+	got := renderZoneFile("Example.com.", records)
+
+	want := "$ORIGIN example.com.\n" +
+		"@\t300\tIN\tA\t192.0.2.1\n" +
+		"@\t300\tIN\tMX\t10 mail.example.com.\n" +
+		"@\t300\tIN\tTXT\t\"say \\\"hi\\\"\"\n" +
+		"_sip._tcp\t300\tIN\tSRV\t10 5 5060 sip.example.net.\n" +
+		"www\t300\tIN\tCNAME\texample.com.\n"
+
+	if got != want {
+		t.Errorf("renderZoneFile() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+// TestRenderZoneFile_RoundTrip confirms the rendered file parses back into the
+// same records, including a TXT answer longer than one character-string.
+func TestRenderZoneFile_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	long := strings.Repeat("k", 300)
+
+	records := []*namecom.Record{
+		{ID: 1, Host: "", Type: "TXT", Answer: long, TTL: 300},
+		{ID: 2, Host: "mail", Type: "MX", Answer: "mx.example.net", TTL: 600, Priority: 5},
+	}
+
+	rendered := renderZoneFile("example.com", records)
+
+	if !strings.Contains(rendered, `" "`) {
+		t.Errorf("a 300-byte TXT answer should be split into several strings:\n%s", rendered)
+	}
+
+	parsed, err := parseZoneFile(rendered, "example.com")
+	if err != nil {
+		t.Fatalf("rendered zone file does not parse: %v", err)
+	}
+
+	if len(parsed) != 2 || parsed[0].Answer != long || parsed[1].Answer != "mx.example.net" || parsed[1].Priority != 5 {
+		t.Errorf("round trip mismatch: %+v", parsed)
+	}
+}
+
+func TestParseZoneFileFunction_Run(t *testing.T) {
+	t.Parallel()
+
+	fn := &parseZoneFileFunction{}
+	elementType := types.ObjectType{AttrTypes: zoneRecordAttrTypes}
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+		types.StringValue("@ MX 10 mail\nwww A 192.0.2.1\n"),
+		types.StringValue("example.com"),
+	})}
+	resp := function.RunResponse{Result: function.NewResultData(types.ListUnknown(elementType))}
+
+	fn.Run(context.Background(), req, &resp)
+
+	if resp.Error != nil {
+		t.Fatalf("unexpected error: %v", resp.Error)
+	}
+
+	list, ok := resp.Result.Value().(types.List)
+	if !ok {
+		t.Fatalf("result is %T, want types.List", resp.Result.Value())
+	}
+
+	var got []zoneRecordModel
+
+	diags := list.ElementsAs(context.Background(), &got, false)
+	if diags.HasError() {
+		t.Fatalf("decoding result: %v", diags)
+	}
+
+	if len(got) != 2 {
+		t.Fatalf("got %d records, want 2", len(got))
+	}
+
+	if got[0].Priority.ValueInt32() != 10 || got[0].Answer.ValueString() != "mail.example.com" {
+		t.Errorf("MX record = %+v", got[0])
+	}
+
+	// Priority is null for types that do not use it, like an unset attribute.
+	if !got[1].Priority.IsNull() || got[1].Host.ValueString() != "www" {
+		t.Errorf("A record = %+v", got[1])
+	}
+}
+
+func TestParseZoneFileFunction_RunError(t *testing.T) {
+	t.Parallel()
+
+	fn := &parseZoneFileFunction{}
+
+	req := function.RunRequest{Arguments: function.NewArgumentsData([]attr.Value{
+		types.StringValue("@ A 192.0.2.1\n@ LOC 52 22 23.000 N 4 53 32.000 E -2.00m\n"),
+		types.StringValue("example.com"),
+	})}
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(types.ObjectType{AttrTypes: zoneRecordAttrTypes})),
+	}
+
+	fn.Run(context.Background(), req, &resp)
+
+	if resp.Error == nil || !strings.Contains(resp.Error.Error(), "line 2") {
+		t.Errorf("expected a line-numbered error, got %v", resp.Error)
+	}
+}
+
+//nolint:paralleltest // exercises the global rate limiter
+func TestZoneFileDataSourceRead(t *testing.T) {
+	InitRateLimiters(defaultPerSecondLimit, defaultPerHourLimit)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4/domains/example.com/records", func(writer http.ResponseWriter, _ *http.Request) {
+		writer.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(writer, `{"records":[{"id":1,"domainName":"example.com","host":"www","type":"A","answer":"192.0.2.1","ttl":300}]}`)
+	})
+
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	src := &zoneFileDataSource{client: namecom.Mock("u", "t", server.URL)}
+
+	var schemaResp datasource.SchemaResponse
+
+	src.Schema(context.Background(), datasource.SchemaRequest{}, &schemaResp)
+
+	config := tfsdk.State{Schema: schemaResp.Schema}
+	config.Set(context.Background(), &zoneFileModel{
+		ID:         types.StringNull(),
+		DomainName: types.StringValue("example.com"),
+		Content:    types.StringNull(),
+	})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+
+	src.Read(context.Background(), datasource.ReadRequest{Config: tfsdk.Config(config)}, &resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var got zoneFileModel
+
+	resp.State.Get(context.Background(), &got)
+
+	want := "$ORIGIN example.com.\nwww\t300\tIN\tA\t192.0.2.1\n"
+	if got.Content.ValueString() != want {
+		t.Errorf("content = %q, want %q", got.Content.ValueString(), want)
+	}
+}